@@ -1,6 +1,7 @@
 package api
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/spf13/viper"
@@ -14,6 +15,11 @@ const clientTimeout = 15 * time.Second
 
 var bugsnagClient *bugsnag.Client
 
+// DefaultClient returns bugsnag client configured from the config file.
+func DefaultClient(debug bool) *bugsnag.Client {
+	return Client(bugsnag.Config{Debug: debug})
+}
+
 // Client initializes and returns bugsnag client.
 func Client(config bugsnag.Config) *bugsnag.Client {
 	if bugsnagClient != nil {
@@ -23,6 +29,15 @@ func Client(config bugsnag.Config) *bugsnag.Client {
 	if config.APIEndpoint == "" {
 		config.APIEndpoint = viper.GetString("api_endpoint")
 	}
+	if config.BuildEndpoint == "" {
+		config.BuildEndpoint = viper.GetString("build_endpoint")
+	}
+	if config.NotifyEndpoint == "" {
+		config.NotifyEndpoint = viper.GetString("notify_endpoint")
+	}
+	if config.SessionsEndpoint == "" {
+		config.SessionsEndpoint = viper.GetString("sessions_endpoint")
+	}
 	if config.Login == "" {
 		config.Login = viper.GetString("login")
 	}
@@ -50,7 +65,44 @@ func Client(config bugsnag.Config) *bugsnag.Client {
 	bugsnagClient = bugsnag.NewClient(
 		config,
 		bugsnag.WithTimeout(clientTimeout),
+		bugsnag.WithRetryPolicy(RetryPolicy()),
 	)
 
 	return bugsnagClient
 }
+
+// NotifierClient returns a new bugsnag client for the build, error reporting and
+// session tracking apis. These are authenticated with the notifier api key of
+// the project, so no api token is looked up and the client is never cached.
+func NotifierClient(config bugsnag.Config) *bugsnag.Client {
+	if config.BuildEndpoint == "" {
+		config.BuildEndpoint = viper.GetString("build_endpoint")
+	}
+	if config.NotifyEndpoint == "" {
+		config.NotifyEndpoint = viper.GetString("notify_endpoint")
+	}
+	if config.SessionsEndpoint == "" {
+		config.SessionsEndpoint = viper.GetString("sessions_endpoint")
+	}
+
+	return bugsnag.NewClient(
+		config,
+		bugsnag.WithTimeout(clientTimeout),
+		bugsnag.WithRetryPolicy(RetryPolicy()),
+	)
+}
+
+// Transport returns a transport configured like the one of the bugsnag client.
+// Unlike Client, it does not look up any credentials.
+func Transport() http.RoundTripper {
+	return bugsnag.NewTransport(clientTimeout)
+}
+
+// RetryPolicy returns the retry policy configured in the config file.
+func RetryPolicy() bugsnag.RetryPolicy {
+	retryPolicy := bugsnag.DefaultRetryPolicy
+	if viper.IsSet("retry_max_attempts") {
+		retryPolicy.MaxAttempts = viper.GetInt("retry_max_attempts")
+	}
+	return retryPolicy
+}
@@ -0,0 +1,314 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	apiClient "github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	"github.com/teamupstart/bugsnag-data-cli/internal/query"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	helpText = `API makes an authenticated request to the bugsnag data access api and prints the response.
+
+The PATH is relative to the configured api endpoint. Placeholders {organization}
+and {project} are replaced with the configured organization and project ids.
+
+Fields passed with --field are sent as query parameters for GET requests and as a
+JSON object body otherwise. The method defaults to POST if fields are given.`
+
+	examples = `# List errors in the configured project
+$ bugsnag api /projects/{project}/errors -f per_page=5
+
+# Fetch all collaborators of the organization, following pagination
+$ bugsnag api /organizations/{organization}/collaborators --paginate --jq '.[].email'
+
+# Resolve an error
+$ bugsnag api -X PATCH /projects/{project}/errors/61a1b2c3d4e5f6a7b8c9d0e1 -f operation=fix
+
+# Send request body from a file or stdin
+$ echo '{"message": "Looking into it"}' | bugsnag api /projects/{project}/errors/ERROR-ID/comments --input -`
+)
+
+type apiParams struct {
+	method   string
+	path     string
+	fields   map[string]string
+	headers  bugsnag.Header
+	input    string
+	paginate bool
+	include  bool
+}
+
+// NewCmdAPI is an api command.
+func NewCmdAPI() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "api PATH",
+		Short:   "API makes an authenticated bugsnag api request",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "PATH\tApi path, eg: /user/organizations",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  apiRequest,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringP("method", "X", "", "HTTP method to use (default \"GET\")")
+	cmd.Flags().StringArrayP("field", "f", nil, "Add a key=value parameter, can be repeated")
+	cmd.Flags().StringArrayP("header", "H", nil, "Add a 'Key: Value' request header, can be repeated")
+	cmd.Flags().String("input", "", "File to use as the request body, use '-' to read from stdin")
+	cmd.Flags().Bool("paginate", false, "Fetch all pages by following the Link header")
+	cmd.Flags().BoolP("include", "i", false, "Include response status line and headers in the output")
+
+	return &cmd
+}
+
+func parseFlags(flags query.FlagParser, path string) (*apiParams, error) {
+	method, err := flags.GetString("method")
+	if err != nil {
+		return nil, err
+	}
+
+	rawFields, err := flags.GetStringArray("field")
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]string, len(rawFields))
+	for _, f := range rawFields {
+		k, v, ok := strings.Cut(f, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid field %q, must be in key=value format", f)
+		}
+		fields[k] = v
+	}
+
+	rawHeaders, err := flags.GetStringArray("header")
+	if err != nil {
+		return nil, err
+	}
+	headers := make(bugsnag.Header, len(rawHeaders))
+	for _, h := range rawHeaders {
+		k, v, ok := strings.Cut(h, ":")
+		if !ok || strings.TrimSpace(k) == "" {
+			return nil, fmt.Errorf("invalid header %q, must be in 'Key: Value' format", h)
+		}
+		headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+
+	input, err := flags.GetString("input")
+	if err != nil {
+		return nil, err
+	}
+
+	paginate, err := flags.GetBool("paginate")
+	if err != nil {
+		return nil, err
+	}
+
+	include, err := flags.GetBool("include")
+	if err != nil {
+		return nil, err
+	}
+
+	if method == "" {
+		method = http.MethodGet
+		if len(fields) > 0 && input == "" {
+			method = http.MethodPost
+		}
+	}
+	method = strings.ToUpper(method)
+
+	if paginate && method != http.MethodGet {
+		return nil, fmt.Errorf("--paginate is only supported for GET requests")
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return &apiParams{
+		method:   method,
+		path:     path,
+		fields:   fields,
+		headers:  headers,
+		input:    input,
+		paginate: paginate,
+		include:  include,
+	}, nil
+}
+
+func apiRequest(cmd *cobra.Command, args []string) {
+	params, err := parseFlags(cmd.Flags(), args[0])
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	// Responses are printed as json unless a payload format is requested,
+	// tabular formats have no view to render from.
+	if cmd.Flags().Changed("output") && !out.IsPayload() {
+		cmdutil.Failed("Output format %q is not supported by api, use json, yaml, template or --jq.", out.Format)
+	}
+
+	client := apiClient.DefaultClient(viper.GetBool("debug"))
+
+	path, err := fillPlaceholders(client, params.path)
+	cmdutil.ExitIfError(err)
+
+	body, err := requestBody(params)
+	cmdutil.ExitIfError(err)
+
+	if len(body) > 0 {
+		if _, ok := params.headers["Content-Type"]; !ok {
+			params.headers["Content-Type"] = "application/json"
+		}
+	}
+	if (params.method == http.MethodGet || params.input != "") && len(params.fields) > 0 {
+		path = withQuery(path, params.fields)
+	}
+
+	var pages []interface{}
+
+	for path != "" {
+		res, err := client.Request(context.Background(), params.method, path, body, params.headers)
+		cmdutil.ExitIfError(err)
+		if res == nil {
+			cmdutil.ExitIfError(bugsnag.ErrEmptyResponse)
+		}
+
+		b, err := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		cmdutil.ExitIfError(err)
+
+		if params.include {
+			printHeaders(os.Stdout, res)
+		}
+
+		if res.StatusCode >= http.StatusBadRequest {
+			if len(b) > 0 {
+				fmt.Println(strings.TrimSuffix(string(b), "\n"))
+			}
+			cmdutil.Failed("bugsnag: Received unexpected response '%s'.", res.Status)
+		}
+
+		path = ""
+		if params.paginate {
+			path, err = client.NextLink(res)
+			cmdutil.ExitIfError(err)
+		}
+
+		var data interface{}
+		if err := json.Unmarshal(b, &data); err != nil {
+			// Not a json response, print as is.
+			_, _ = os.Stdout.Write(b)
+			continue
+		}
+
+		if !params.paginate {
+			cmdutil.ExitIfError(printJSON(out, data))
+			continue
+		}
+		pages = append(pages, data)
+	}
+
+	if len(pages) > 0 {
+		cmdutil.ExitIfError(printJSON(out, mergePages(pages)))
+	}
+}
+
+func printJSON(out *output.Options, data interface{}) error {
+	if out.JQ != "" || out.Format == output.FormatYAML || out.Format == output.FormatTemplate {
+		return out.Print(os.Stdout, data, nil)
+	}
+	return view.JSON(os.Stdout, data)
+}
+
+// mergePages concatenates pages if all of them are arrays.
+func mergePages(pages []interface{}) interface{} {
+	if len(pages) == 1 {
+		return pages[0]
+	}
+
+	var merged []interface{}
+	for _, p := range pages {
+		items, ok := p.([]interface{})
+		if !ok {
+			return pages
+		}
+		merged = append(merged, items...)
+	}
+	return merged
+}
+
+func fillPlaceholders(client *bugsnag.Client, path string) (string, error) {
+	if strings.Contains(path, "{organization}") {
+		org, err := cmdutil.ResolveOrganization(client, "")
+		if err != nil {
+			return "", err
+		}
+		path = strings.ReplaceAll(path, "{organization}", org.ID)
+	}
+	if strings.Contains(path, "{project}") {
+		projectID, err := cmdutil.ResolveProjectID(client, "")
+		if err != nil {
+			return "", err
+		}
+		path = strings.ReplaceAll(path, "{project}", projectID)
+	}
+	return path, nil
+}
+
+func requestBody(params *apiParams) ([]byte, error) {
+	if params.input != "" {
+		return cmdutil.ReadFile(params.input)
+	}
+	if params.method == http.MethodGet || len(params.fields) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(params.fields)
+}
+
+func withQuery(path string, fields map[string]string) string {
+	q := url.Values{}
+	for k, v := range fields {
+		q.Set(k, v)
+	}
+
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return path + sep + q.Encode()
+}
+
+func printHeaders(w io.Writer, res *http.Response) {
+	fmt.Fprintf(w, "%s %s\n", res.Proto, res.Status)
+
+	keys := make([]string, 0, len(res.Header))
+	for k := range res.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s: %s\n", k, strings.Join(res.Header[k], ", "))
+	}
+	fmt.Fprintln(w)
+}
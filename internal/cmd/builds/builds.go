@@ -0,0 +1,31 @@
+package builds
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/builds/notify"
+)
+
+const helpText = `Builds reports builds and deploys to the bugsnag build api. See available commands below.`
+
+// NewCmdBuilds is a builds command.
+func NewCmdBuilds() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "builds",
+		Short:       "Builds reports builds and deploys",
+		Long:        helpText,
+		Aliases:     []string{"build", "deploys"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        builds,
+	}
+
+	cmd.AddCommand(
+		notify.NewCmdNotify(),
+	)
+
+	return &cmd
+}
+
+func builds(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,183 @@
+package notify
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	helpText = `Notify reports a build, or a deploy, of an app version to the bugsnag build api.
+
+Unless given with --revision and --repository, the revision and the url of the
+remote are read from the git repository in the working directory. Credentials
+in the remote url are never sent.
+
+The build is authenticated with the notifier api key of the project, which
+can also be set with the BUGSNAG_API_KEY env variable. No api token is needed.`
+
+	examples = `# Report a production deploy of the checked out commit
+$ bugsnag builds notify --api-key $BUGSNAG_API_KEY --app-version 2.4.1 --release-stage production
+
+# Report a build of a specific revision
+$ bugsnag builds notify --app-version 2.4.1 --builder ci \
+    --repository https://github.com/teamupstart/web-app --revision 9f2c4e1
+
+# Test against a local endpoint
+$ bugsnag builds notify --app-version 2.4.1 --endpoint http://localhost:8080`
+
+	defaultRemote = "origin"
+)
+
+// NewCmdNotify is a notify command.
+func NewCmdNotify() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "notify",
+		Short:       "Notify reports a build to bugsnag",
+		Long:        helpText,
+		Example:     examples,
+		Annotations: map[string]string{"cmd:no-token": "true"},
+		Args:        cobra.NoArgs,
+		Run:         notify,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("api-key", "", "Notifier api key of the project (default $BUGSNAG_API_KEY)")
+	cmd.Flags().String("app-version", "", "Version of the app being built")
+	cmd.Flags().String("release-stage", "", "Release stage the build is deployed to, eg: production")
+	cmd.Flags().String("builder", "", "Name of the person or machine running the build (default current user)")
+	cmd.Flags().String("revision", "", "Source control revision of the build (default git HEAD)")
+	cmd.Flags().String("repository", "", "Url of the repository (default url of the git remote)")
+	cmd.Flags().String("remote", defaultRemote, "Git remote to read the repository url from")
+	cmd.Flags().String(
+		"provider", "",
+		"Source control provider if it can't be told from the repository url, eg: github-enterprise, gitlab-onpremise",
+	)
+	cmd.Flags().String("app-version-code", "", "Version code of an android app")
+	cmd.Flags().String("app-bundle-version", "", "Bundle version of an ios or macos app")
+	cmd.Flags().StringToString("metadata", nil, "Additional key=value metadata of the build, can be repeated")
+	cmd.Flags().String("endpoint", "", "Build api endpoint (default $BUGSNAG_BUILD_ENDPOINT or "+bugsnag.DefaultBuildEndpoint+")")
+
+	_ = cmd.MarkFlagRequired("app-version")
+
+	return &cmd
+}
+
+func notify(cmd *cobra.Command, _ []string) {
+	flags := cmd.Flags()
+
+	build, err := buildFromFlags(cmd)
+	cmdutil.ExitIfError(err)
+
+	endpoint, err := flags.GetString("endpoint")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(flags)
+	cmdutil.ExitIfError(err)
+
+	res, err := func() (*bugsnag.BuildResponse, error) {
+		s := cmdutil.Info("Reporting build...")
+		defer s.Stop()
+
+		client := api.NotifierClient(bugsnag.Config{BuildEndpoint: endpoint, Debug: viper.GetBool("debug")})
+
+		return client.NotifyBuild(build)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if !out.IsHuman() {
+		cmdutil.ExitIfError(out.Print(os.Stdout, res, view.Value{Name: "STATUS", Value: res.Status}))
+		return
+	}
+
+	for _, w := range res.Warnings {
+		cmdutil.Warn("Warning: %s", w)
+	}
+	cmdutil.Success("Build %s reported to bugsnag", build.AppVersion)
+}
+
+func buildFromFlags(cmd *cobra.Command) (*bugsnag.Build, error) {
+	flags := cmd.Flags()
+
+	var (
+		build bugsnag.Build
+		sc    bugsnag.SourceControl
+		err   error
+	)
+	for name, dst := range map[string]*string{
+		"api-key":            &build.APIKey,
+		"app-version":        &build.AppVersion,
+		"release-stage":      &build.ReleaseStage,
+		"builder":            &build.BuilderName,
+		"app-version-code":   &build.AppVersionCode,
+		"app-bundle-version": &build.AppBundleVersion,
+		"revision":           &sc.Revision,
+		"repository":         &sc.Repository,
+		"provider":           &sc.Provider,
+	} {
+		if *dst, err = flags.GetString(name); err != nil {
+			return nil, err
+		}
+	}
+
+	if build.Metadata, err = flags.GetStringToString("metadata"); err != nil {
+		return nil, err
+	}
+
+	if build.APIKey == "" {
+		build.APIKey = viper.GetString("api_key")
+	}
+	if build.BuilderName == "" {
+		if u, err := user.Current(); err == nil {
+			build.BuilderName = u.Username
+		}
+	}
+
+	remote, err := flags.GetString("remote")
+	if err != nil {
+		return nil, err
+	}
+	if build.SourceControl, err = sourceControl(sc, ".", remote); err != nil {
+		return nil, err
+	}
+
+	return &build, build.Validate()
+}
+
+// sourceControl fills in the revision and repository missing from sc from the
+// git repository containing dir. Source control is left out of the build if
+// neither was given and dir is not in a git repository.
+func sourceControl(sc bugsnag.SourceControl, dir, remote string) (*bugsnag.SourceControl, error) {
+	given := sc.Revision != "" || sc.Repository != ""
+
+	if sc.Revision == "" {
+		rev, err := cmdutil.GitRevision(dir)
+		if err != nil && given {
+			return nil, fmt.Errorf("could not detect git revision, please pass one with --revision: %w", err)
+		}
+		if err != nil {
+			cmdutil.Warn("Not a git repository, reporting the build without source control details.")
+			return nil, nil
+		}
+		sc.Revision = rev
+	}
+	if sc.Repository == "" {
+		repo, err := cmdutil.GitRemoteURL(dir, remote)
+		if err != nil {
+			return nil, fmt.Errorf("could not detect repository url, please pass one with --repository: %w", err)
+		}
+		sc.Repository = repo
+	}
+
+	return &sc, nil
+}
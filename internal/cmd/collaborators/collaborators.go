@@ -0,0 +1,33 @@
+package collaborators
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/collaborators/list"
+)
+
+const helpText = `Collaborators lists members of a bugsnag organization. See available commands below.`
+
+// NewCmdCollaborators is a collaborators command.
+func NewCmdCollaborators() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "collaborators",
+		Short:       "Collaborators lists organization members",
+		Long:        helpText,
+		Aliases:     []string{"collaborator", "members"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        collaborators,
+	}
+
+	cmd.PersistentFlags().String("org", "", "Organization id, slug or name (defaults to the configured organization)")
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+	)
+
+	return &cmd
+}
+
+func collaborators(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,89 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List lists organization collaborators",
+		Long:    "List lists collaborators of an organization with their role and last login.",
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+
+	cmd.Flags().Int("limit", 0, "Maximum number of collaborators to fetch, 0 fetches all")
+
+	return &cmd
+}
+
+// List displays a list of collaborators.
+func List(cmd *cobra.Command, _ []string) {
+	org, err := cmd.Flags().GetString("org")
+	cmdutil.ExitIfError(err)
+
+	limit, err := cmd.Flags().GetInt("limit")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	collaborators, total, err := func() ([]*bugsnag.Collaborator, int, error) {
+		s := cmdutil.Info("Fetching collaborators...")
+		defer s.Stop()
+
+		client := api.DefaultClient(viper.GetBool("debug"))
+
+		o, err := cmdutil.ResolveOrganization(client, org)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		pager := client.CollaboratorsPager(o.ID, &bugsnag.ListOptions{Limit: limit})
+		collaborators, err := bugsnag.Collect(context.Background(), pager, limit)
+
+		return collaborators, pager.Total(), err
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(collaborators) == 0 && out.IsHuman() {
+		fmt.Println()
+		cmdutil.Failed("No collaborators found.")
+		return
+	}
+
+	table := tuiView.Table{
+		Header: []string{"ID", "NAME", "EMAIL", "ROLE", "LAST LOGIN"},
+	}
+	for _, c := range collaborators {
+		lastLogin := "never"
+		if c.LastRequestAt != "" {
+			lastLogin = cmdutil.FormatDateTimeHuman(c.LastRequestAt, bugsnag.ISO8601)
+		}
+		table.Rows = append(table.Rows, []string{
+			c.ID,
+			tuiView.OrBlank(c.Name),
+			c.Email,
+			c.Role(),
+			lastLogin,
+		})
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, collaborators, &table))
+	if out.IsHuman() {
+		tuiView.Footer(os.Stderr, len(collaborators), total, "collaborators")
+	}
+}
@@ -0,0 +1,102 @@
+package assign
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	helpText = `Assign assigns errors to a collaborator of the project's organization.
+
+The assignee can be an email address, a collaborator id, 'me' to assign
+to yourself, or 'none' to unassign the errors.`
+	examples = `$ bugsnag errors assign 61a1b2c3d4e5f6a7b8c9d0e1 --to jane@example.com
+
+# Assign to yourself
+$ bugsnag errors assign 61a1b2c3d4e5f6a7b8c9d0e1 --to me
+
+# Unassign
+$ bugsnag errors assign 61a1b2c3d4e5f6a7b8c9d0e1 --to none`
+
+	assigneeNone = "none"
+)
+
+// NewCmdAssign is an assign command.
+func NewCmdAssign() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "assign ERROR-ID...",
+		Short:   "Assign assigns errors to a collaborator",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"asg"},
+		Annotations: map[string]string{
+			"help:args": "ERROR-ID\tIds of the errors to assign",
+		},
+		Args: cobra.MinimumNArgs(1),
+		Run:  assign,
+	}
+
+	cmd.Flags().String("to", "", "Email or id of the assignee, 'me' or 'none'")
+	_ = cmd.MarkFlagRequired("to")
+
+	return &cmd
+}
+
+func assign(cmd *cobra.Command, args []string) {
+	to, err := cmd.Flags().GetString("to")
+	cmdutil.ExitIfError(err)
+
+	to = strings.TrimSpace(to)
+	if to == "" {
+		cmdutil.Failed("Error: --to cannot be empty, use 'none' to unassign")
+	}
+
+	client := api.DefaultClient(viper.GetBool("debug"))
+
+	project, assignee, err := func() (*bugsnag.Project, *bugsnag.Collaborator, error) {
+		s := cmdutil.Info(fmt.Sprintf("Looking up %q...", to))
+		defer s.Stop()
+
+		project, err := cmdutil.ResolveProject(client, "", "")
+		if err != nil || strings.EqualFold(to, assigneeNone) {
+			return project, nil, err
+		}
+
+		assignee, err := cmdutil.ResolveCollaborator(client, project.OrganizationID, to)
+		return project, assignee, err
+	}()
+	cmdutil.ExitIfError(err)
+
+	update := bugsnag.ErrorUpdate{Operation: bugsnag.ErrorOperationAssign}
+	if assignee != nil {
+		update.AssignedCollaboratorID = &assignee.ID
+	}
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Assigning %d errors...", len(args)))
+		defer s.Stop()
+
+		return client.UpdateErrors(project.ID, args, &update)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if assignee == nil {
+		cmdutil.Success("Unassigned %d errors", len(args))
+		return
+	}
+	cmdutil.Success("Assigned %d errors to %s", len(args), assigneeName(assignee))
+}
+
+func assigneeName(c *bugsnag.Collaborator) string {
+	if c.Name == "" {
+		return c.Email
+	}
+	return fmt.Sprintf("%s <%s>", c.Name, c.Email)
+}
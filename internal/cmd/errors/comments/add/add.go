@@ -0,0 +1,72 @@
+package add
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	helpText = `Add adds a comment to an error.
+
+The message is read from --body, --body-file or stdin. If none is given,
+your $EDITOR is opened to write the comment.`
+	examples = `$ bugsnag errors comments add 61a1b2c3d4e5f6a7b8c9d0e1 --body "Caused by the 1.4.2 rollout"
+
+# Read the comment from a file
+$ bugsnag errors comments add 61a1b2c3d4e5f6a7b8c9d0e1 --body-file notes.md
+
+# Pipe the comment from another command
+$ echo "Rolled back" | bugsnag errors comments add 61a1b2c3d4e5f6a7b8c9d0e1`
+)
+
+// NewCmdAdd is an add command.
+func NewCmdAdd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "add ERROR-ID",
+		Short:   "Add adds a comment to an error",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"create"},
+		Annotations: map[string]string{
+			"help:args": "ERROR-ID\tId of the error, eg: 61a1b2c3d4e5f6a7b8c9d0e1",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  add,
+	}
+
+	cmd.Flags().StringP("body", "b", "", "Comment message")
+	cmd.Flags().StringP("body-file", "F", "", "Read the comment message from a file, use - for stdin")
+
+	return &cmd
+}
+
+func add(cmd *cobra.Command, args []string) {
+	body, err := cmd.Flags().GetString("body")
+	cmdutil.ExitIfError(err)
+
+	bodyFile, err := cmd.Flags().GetString("body-file")
+	cmdutil.ExitIfError(err)
+
+	msg, err := cmdutil.ReadMessage(body, bodyFile, "")
+	cmdutil.ExitIfError(err)
+
+	comment, err := func() (*bugsnag.Comment, error) {
+		s := cmdutil.Info("Adding comment...")
+		defer s.Stop()
+
+		client := api.DefaultClient(viper.GetBool("debug"))
+
+		projectID, err := cmdutil.ResolveProjectID(client, "")
+		if err != nil {
+			return nil, err
+		}
+		return client.AddComment(projectID, args[0], msg)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Comment %s added to error %s", comment.ID, args[0])
+}
@@ -0,0 +1,36 @@
+package comments
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/errors/comments/add"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/errors/comments/delete"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/errors/comments/edit"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/errors/comments/list"
+)
+
+const helpText = `Comments manages comments on an error. See available commands below.`
+
+// NewCmdComments is a comments command.
+func NewCmdComments() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "comments",
+		Short:   "Comments manages error comments",
+		Long:    helpText,
+		Aliases: []string{"comment"},
+		RunE:    comments,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		add.NewCmdAdd(),
+		edit.NewCmdEdit(),
+		delete.NewCmdDelete(),
+	)
+
+	return &cmd
+}
+
+func comments(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,61 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+)
+
+// NewCmdDelete is a delete command.
+func NewCmdDelete() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "delete COMMENT-ID",
+		Short:   "Delete deletes a comment",
+		Long:    "Delete deletes a comment. You are asked to confirm unless --yes is passed.",
+		Example: "$ bugsnag errors comments delete 62a1b2c3d4e5f6a7b8c9d0e1 --yes",
+		Aliases: []string{"remove", "rm"},
+		Annotations: map[string]string{
+			"help:args": "COMMENT-ID\tId of the comment, see 'bugsnag errors comments list'",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  del,
+	}
+
+	cmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+
+	return &cmd
+}
+
+func del(cmd *cobra.Command, args []string) {
+	yes, err := cmd.Flags().GetBool("yes")
+	cmdutil.ExitIfError(err)
+
+	if !yes {
+		if !cmdutil.StdinIsTerminal() {
+			cmdutil.Failed("Error: --yes is required when not running interactively")
+		}
+
+		prompt := &survey.Confirm{Message: fmt.Sprintf("Delete comment %s?", args[0])}
+		cmdutil.ExitIfError(survey.AskOne(prompt, &yes))
+		if !yes {
+			return
+		}
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Deleting comment...")
+		defer s.Stop()
+
+		client := api.DefaultClient(viper.GetBool("debug"))
+
+		return client.DeleteComment(args[0])
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Comment %s deleted", args[0])
+}
@@ -0,0 +1,87 @@
+package edit
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Edit replaces the message of a comment.
+
+The message is read from --body, --body-file or stdin. If none is given,
+your $EDITOR is opened with the current message.`
+	examples = `$ bugsnag errors comments edit 62a1b2c3d4e5f6a7b8c9d0e1 --body "Fixed in 1.4.3"
+
+# Edit the current message in $EDITOR
+$ bugsnag errors comments edit 62a1b2c3d4e5f6a7b8c9d0e1`
+)
+
+// NewCmdEdit is an edit command.
+func NewCmdEdit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "edit COMMENT-ID",
+		Short:   "Edit edits a comment",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"update"},
+		Annotations: map[string]string{
+			"help:args": "COMMENT-ID\tId of the comment, see 'bugsnag errors comments list'",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  edit,
+	}
+
+	cmd.Flags().StringP("body", "b", "", "New comment message")
+	cmd.Flags().StringP("body-file", "F", "", "Read the new comment message from a file, use - for stdin")
+
+	return &cmd
+}
+
+func edit(cmd *cobra.Command, args []string) {
+	body, err := cmd.Flags().GetString("body")
+	cmdutil.ExitIfError(err)
+
+	bodyFile, err := cmd.Flags().GetString("body-file")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(viper.GetBool("debug"))
+
+	var current string
+	if body == "" && bodyFile == "" && !cmdutil.StdinHasData() && cmdutil.StdinIsTerminal() {
+		comment, err := func() (string, error) {
+			s := cmdutil.Info("Fetching comment...")
+			defer s.Stop()
+
+			c, err := client.GetComment(args[0])
+			if err != nil {
+				return "", err
+			}
+			return c.Message, nil
+		}()
+		cmdutil.ExitIfError(err)
+
+		current = comment
+	}
+
+	msg, err := cmdutil.ReadMessage(body, bodyFile, current)
+	cmdutil.ExitIfError(err)
+
+	if msg == current {
+		cmdutil.Warn("Comment unchanged.")
+		return
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Updating comment...")
+		defer s.Stop()
+
+		_, err := client.EditComment(args[0], msg)
+		return err
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Comment %s updated", args[0])
+}
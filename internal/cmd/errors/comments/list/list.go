@@ -0,0 +1,88 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const maxMessageLength = 80
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list ERROR-ID",
+		Short:   "List lists comments on an error",
+		Long:    "List lists comments on an error, oldest first.",
+		Example: "$ bugsnag errors comments list 61a1b2c3d4e5f6a7b8c9d0e1",
+		Aliases: []string{"lists", "ls"},
+		Annotations: map[string]string{
+			"help:args": "ERROR-ID\tId of the error, eg: 61a1b2c3d4e5f6a7b8c9d0e1",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  List,
+	}
+
+	cmd.Flags().Int("limit", 0, "Maximum number of comments to fetch, 0 fetches all")
+
+	return &cmd
+}
+
+// List displays a list of comments.
+func List(cmd *cobra.Command, args []string) {
+	limit, err := cmd.Flags().GetInt("limit")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	comments, total, err := func() ([]*bugsnag.Comment, int, error) {
+		s := cmdutil.Info("Fetching comments...")
+		defer s.Stop()
+
+		client := api.DefaultClient(viper.GetBool("debug"))
+
+		projectID, err := cmdutil.ResolveProjectID(client, "")
+		if err != nil {
+			return nil, 0, err
+		}
+
+		pager := client.CommentsPager(projectID, args[0], &bugsnag.ListOptions{Limit: limit})
+		comments, err := bugsnag.Collect(context.Background(), pager, limit)
+
+		return comments, pager.Total(), err
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(comments) == 0 && out.IsHuman() {
+		fmt.Println()
+		cmdutil.Failed("No comments found.")
+		return
+	}
+
+	table := tuiView.Table{
+		Header: []string{"ID", "AUTHOR", "CREATED", "MESSAGE"},
+	}
+	for _, c := range comments {
+		table.Rows = append(table.Rows, []string{
+			c.ID,
+			tuiView.OrBlank(c.Author()),
+			cmdutil.FormatDateTimeHuman(c.CreatedAt, bugsnag.ISO8601),
+			tuiView.Shorten(c.Message, maxMessageLength),
+		})
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, comments, &table))
+	if out.IsHuman() {
+		tuiView.Footer(os.Stderr, len(comments), total, "comments")
+	}
+}
@@ -0,0 +1,42 @@
+package errors
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/errors/assign"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/errors/comments"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/errors/list"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/errors/status"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/errors/view"
+)
+
+const helpText = `Errors manages errors reported to a bugsnag project. See available commands below.`
+
+// NewCmdErrors is an errors command.
+func NewCmdErrors() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "errors",
+		Short:       "Errors manages bugsnag errors",
+		Long:        helpText,
+		Aliases:     []string{"error"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        errors,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		view.NewCmdView(),
+		status.NewCmdResolve(),
+		status.NewCmdIgnore(),
+		status.NewCmdReopen(),
+		status.NewCmdSnooze(),
+		assign.NewCmdAssign(),
+		comments.NewCmdComments(),
+	)
+
+	return &cmd
+}
+
+func errors(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
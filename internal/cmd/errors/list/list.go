@@ -0,0 +1,133 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	"github.com/teamupstart/bugsnag-data-cli/internal/query"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	maxMessageLength = 60
+	defaultLimit     = 100
+
+	examples = `# List open errors in the configured project
+$ bugsnag errors list --filter status=open
+
+# List production errors seen in the last day, sorted by number of users
+$ bugsnag errors list --filter stage=production --filter since=1d --sort users
+
+# Errors not seen on a release stage, in a different project
+$ bugsnag errors list --filter app.release_stage=!development -p web-app`
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List lists errors in a project",
+		Long:    "List lists errors reported to a bugsnag project.",
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringToStringP("filter", "f", nil, query.FilterHelp("errors"))
+	_ = cmd.RegisterFlagCompletionFunc("filter", cmdutil.CompleteFilter)
+	cmd.Flags().String("sort", bugsnag.ErrorSortLastSeen, "Sort errors by: last_seen, first_seen, events, users or unsorted")
+	cmd.Flags().Bool("reverse", false, "Reverse the sort order")
+	cmd.Flags().Int("limit", defaultLimit, "Maximum number of errors to fetch, 0 fetches all errors")
+
+	return &cmd
+}
+
+// List displays a list of errors.
+func List(cmd *cobra.Command, _ []string) {
+	flags := cmd.Flags()
+
+	filters, err := query.Filters(flags)
+	cmdutil.ExitIfError(err)
+
+	sort, err := flags.GetString("sort")
+	cmdutil.ExitIfError(err)
+	switch sort {
+	case bugsnag.ErrorSortLastSeen, bugsnag.ErrorSortFirstSeen, bugsnag.ErrorSortEvents,
+		bugsnag.ErrorSortUsers, bugsnag.ErrorSortUnsorted:
+	default:
+		cmdutil.Failed("Invalid sort %q. Must be one of: last_seen, first_seen, events, users, unsorted.", sort)
+	}
+
+	reverse, err := flags.GetBool("reverse")
+	cmdutil.ExitIfError(err)
+
+	limit, err := flags.GetInt("limit")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(flags)
+	cmdutil.ExitIfError(err)
+
+	direction := "desc"
+	if reverse {
+		direction = "asc"
+	}
+
+	client := api.DefaultClient(viper.GetBool("debug"))
+
+	projectID, err := cmdutil.ResolveProjectID(client, "")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cmdutil.ValidateFilters(client, projectID, filters))
+
+	errs, total, err := func() ([]*bugsnag.Error, int, error) {
+		s := cmdutil.Info("Fetching errors...")
+		defer s.Stop()
+
+		pager := client.ErrorsPager(projectID, filters, &bugsnag.ListErrorsOptions{
+			ListOptions: bugsnag.ListOptions{Limit: limit},
+			Sort:        sort,
+			Direction:   direction,
+		})
+		errs, err := bugsnag.Collect(context.Background(), pager, limit)
+
+		return errs, pager.Total(), err
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(errs) == 0 && out.IsHuman() {
+		fmt.Println()
+		cmdutil.Failed("No errors found.")
+		return
+	}
+
+	table := tuiView.Table{
+		Header: []string{"ID", "CLASS", "MESSAGE", "SEVERITY", "STATUS", "EVENTS", "USERS", "LAST SEEN"},
+	}
+	for _, e := range errs {
+		table.Rows = append(table.Rows, []string{
+			e.ID,
+			e.ErrorClass,
+			tuiView.OrBlank(tuiView.Shorten(e.Message, maxMessageLength)),
+			e.Severity,
+			e.Status,
+			strconv.Itoa(e.Events),
+			strconv.Itoa(e.Users),
+			cmdutil.FormatDateTimeHuman(e.LastSeen, bugsnag.ISO8601),
+		})
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, errs, &table))
+	if out.IsHuman() {
+		tuiView.Footer(os.Stderr, len(errs), total, "errors")
+	}
+}
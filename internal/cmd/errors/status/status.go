@@ -0,0 +1,311 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/query"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	maxMessageLength = 60
+
+	filterHelp = `Act on every error matching the filter, can be repeated.
+Accepts the same filters as 'bugsnag errors list'`
+)
+
+type action struct {
+	use       string
+	operation string
+	short     string
+	long      string
+	verb      string
+	examples  string
+	aliases   []string
+}
+
+type statusParams struct {
+	ids         []string
+	filters     bugsnag.Filters
+	dryRun      bool
+	reopenRules *bugsnag.ReopenRules
+}
+
+// NewCmdResolve is a resolve command.
+func NewCmdResolve() *cobra.Command {
+	return newCmd(&action{
+		use:       "resolve",
+		operation: bugsnag.ErrorOperationFix,
+		short:     "Resolve marks errors as fixed",
+		long:      "Resolve marks errors as fixed. Fixed errors are reopened if they occur again.",
+		verb:      "Resolved",
+		aliases:   []string{"fix"},
+		examples: `$ bugsnag errors resolve 61a1b2c3d4e5f6a7b8c9d0e1 61a1b2c3d4e5f6a7b8c9d0e2
+
+# Resolve every open error last seen on an old app version
+$ bugsnag errors resolve --filter status=open --filter version=1.2.0 --dry-run`,
+	})
+}
+
+// NewCmdIgnore is an ignore command.
+func NewCmdIgnore() *cobra.Command {
+	return newCmd(&action{
+		use:       "ignore",
+		operation: bugsnag.ErrorOperationIgnore,
+		short:     "Ignore ignores errors",
+		long:      "Ignore ignores errors. Ignored errors are never reopened and don't trigger notifications.",
+		verb:      "Ignored",
+		examples:  `$ bugsnag errors ignore 61a1b2c3d4e5f6a7b8c9d0e1`,
+	})
+}
+
+// NewCmdReopen is a reopen command.
+func NewCmdReopen() *cobra.Command {
+	return newCmd(&action{
+		use:       "reopen",
+		operation: bugsnag.ErrorOperationOpen,
+		short:     "Reopen marks errors as open",
+		long:      "Reopen marks fixed, snoozed or ignored errors as open.",
+		verb:      "Reopened",
+		aliases:   []string{"open"},
+		examples:  `$ bugsnag errors reopen 61a1b2c3d4e5f6a7b8c9d0e1`,
+	})
+}
+
+// NewCmdSnooze is a snooze command.
+func NewCmdSnooze() *cobra.Command {
+	cmd := newCmd(&action{
+		use:       "snooze",
+		operation: bugsnag.ErrorOperationSnooze,
+		short:     "Snooze snoozes errors until a condition is met",
+		long:      "Snooze snoozes errors until they occur after a period, or a number of additional events or users.",
+		verb:      "Snoozed",
+		examples: `# Snooze for a day
+$ bugsnag errors snooze 61a1b2c3d4e5f6a7b8c9d0e1 --for 24h
+
+# Snooze until the error happens 100 more times
+$ bugsnag errors snooze 61a1b2c3d4e5f6a7b8c9d0e1 --until-events 100`,
+	})
+
+	cmd.Flags().String("for", "", "Reopen the error if it occurs after the duration, eg: 24h")
+	cmd.Flags().Uint("until-events", 0, "Reopen the error after this many additional events")
+	cmd.Flags().Uint("until-users", 0, "Reopen the error after this many additional affected users")
+
+	return cmd
+}
+
+func newCmd(a *action) *cobra.Command {
+	cmd := cobra.Command{
+		Use:     a.use + " [ERROR-ID...]",
+		Short:   a.short,
+		Long:    a.long,
+		Example: a.examples,
+		Aliases: a.aliases,
+		Annotations: map[string]string{
+			"help:args": "ERROR-ID\tIds of the errors, can be omitted if --filter is used",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			run(cmd, args, a)
+		},
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringToStringP("filter", "f", nil, filterHelp)
+	_ = cmd.RegisterFlagCompletionFunc("filter", cmdutil.CompleteFilter)
+	cmd.Flags().Bool("dry-run", false, "Print errors that would be updated without updating them")
+
+	return &cmd
+}
+
+func parseFlags(flags query.FlagParser, args []string, a *action) (*statusParams, error) {
+	filters, err := query.Filters(flags)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 && len(filters) == 0 {
+		return nil, fmt.Errorf("either error ids or --filter is required")
+	}
+	if len(args) > 0 && len(filters) > 0 {
+		return nil, fmt.Errorf("error ids and --filter cannot be used together")
+	}
+
+	dryRun, err := flags.GetBool("dry-run")
+	if err != nil {
+		return nil, err
+	}
+
+	params := statusParams{ids: args, filters: filters, dryRun: dryRun}
+
+	if a.operation == bugsnag.ErrorOperationSnooze {
+		if params.reopenRules, err = reopenRules(flags); err != nil {
+			return nil, err
+		}
+	}
+
+	return &params, nil
+}
+
+func reopenRules(flags query.FlagParser) (*bugsnag.ReopenRules, error) {
+	raw, err := flags.GetString("for")
+	if err != nil {
+		return nil, err
+	}
+	var period time.Duration
+	if raw != "" {
+		if period, err = time.ParseDuration(raw); err != nil || period <= 0 {
+			return nil, fmt.Errorf("invalid duration %q for --for, eg: 24h or 90m", raw)
+		}
+	}
+
+	events, err := flags.GetUint("until-events")
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := flags.GetUint("until-users")
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []*bugsnag.ReopenRules
+	if period > 0 {
+		rules = append(rules, &bugsnag.ReopenRules{ReopenIf: bugsnag.ReopenIfOccursAfter, Seconds: int(period.Seconds())})
+	}
+	if events > 0 {
+		rules = append(rules, &bugsnag.ReopenRules{ReopenIf: bugsnag.ReopenIfAdditionalOccurrences, AdditionalOccurrences: int(events)})
+	}
+	if users > 0 {
+		rules = append(rules, &bugsnag.ReopenRules{ReopenIf: bugsnag.ReopenIfAdditionalUsers, AdditionalUsers: int(users)})
+	}
+
+	if len(rules) != 1 {
+		return nil, fmt.Errorf("exactly one of --for, --until-events or --until-users is required")
+	}
+	return rules[0], nil
+}
+
+func run(cmd *cobra.Command, args []string, a *action) {
+	params, err := parseFlags(cmd.Flags(), args, a)
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(viper.GetBool("debug"))
+
+	projectID, err := cmdutil.ResolveProjectID(client, "")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cmdutil.ValidateFilters(client, projectID, params.filters))
+
+	var (
+		ids  = params.ids
+		errs []*bugsnag.Error
+	)
+	if len(params.filters) > 0 {
+		errs, err = func() ([]*bugsnag.Error, error) {
+			s := cmdutil.Info("Fetching matching errors...")
+			defer s.Stop()
+
+			return bugsnag.Collect(context.Background(), client.ErrorsPager(projectID, params.filters, nil), 0)
+		}()
+		cmdutil.ExitIfError(err)
+
+		if len(errs) == 0 {
+			cmdutil.Failed("No errors matched the filter.")
+		}
+
+		ids = make([]string, 0, len(errs))
+		for _, e := range errs {
+			ids = append(ids, e.ID)
+		}
+	}
+
+	if params.dryRun {
+		dryRun(client, projectID, ids, errs, a)
+		return
+	}
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Updating %d errors...", len(ids)))
+		defer s.Stop()
+
+		return client.UpdateErrors(projectID, ids, &bugsnag.ErrorUpdate{
+			Operation:   a.operation,
+			ReopenRules: params.reopenRules,
+		})
+	}()
+	if e, ok := err.(*bugsnag.ErrMultipleFailed); ok && e.Failed > 0 && e.Failed < len(ids) {
+		cmdutil.Fail("Some errors could not be updated:%s", e.Msg)
+		cmdutil.Failed("%s %d of %d errors, %d failed.", a.verb, len(ids)-e.Failed, len(ids), e.Failed)
+	}
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("%s %d errors", a.verb, len(ids))
+}
+
+// dryRun prints errors that would be updated. Errors given by id are fetched
+// first so that ids that don't exist are reported before any update is made.
+func dryRun(client *bugsnag.Client, projectID string, ids []string, errs []*bugsnag.Error, a *action) {
+	var failed []string
+	if errs == nil {
+		errs, failed = fetchErrors(client, projectID, ids)
+	}
+
+	if len(errs) > 0 {
+		printPreview(errs)
+	}
+	for _, f := range failed {
+		cmdutil.Fail("%s", f)
+	}
+	if len(failed) > 0 {
+		cmdutil.Failed("Dry run: %d of %d errors could not be fetched.", len(failed), len(ids))
+	}
+
+	cmdutil.Warn("\nDry run: %d errors would be %s.", len(errs), strings.ToLower(a.verb))
+}
+
+func fetchErrors(client *bugsnag.Client, projectID string, ids []string) ([]*bugsnag.Error, []string) {
+	s := cmdutil.Info(fmt.Sprintf("Fetching %d errors...", len(ids)))
+	defer s.Stop()
+
+	var (
+		errs   []*bugsnag.Error
+		failed []string
+	)
+	for _, id := range ids {
+		e, err := client.GetError(projectID, id)
+		if err != nil {
+			msg := err.Error()
+			if ue, ok := err.(*bugsnag.ErrUnexpectedResponse); ok {
+				msg = ue.Status
+			}
+			failed = append(failed, fmt.Sprintf("%s: %s", id, msg))
+			continue
+		}
+		errs = append(errs, e)
+	}
+	return errs, failed
+}
+
+func printPreview(errs []*bugsnag.Error) {
+	table := view.Table{Header: []string{"ID", "CLASS", "MESSAGE", "STATUS", "EVENTS"}}
+	for _, e := range errs {
+		table.Rows = append(table.Rows, []string{
+			e.ID,
+			e.ErrorClass,
+			view.OrBlank(view.Shorten(e.Message, maxMessageLength)),
+			e.Status,
+			strconv.Itoa(e.Events),
+		})
+	}
+	cmdutil.ExitIfError(table.Render(os.Stdout))
+}
@@ -0,0 +1,81 @@
+package view
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	defaultFrames = 10
+
+	examples = `$ bugsnag errors view 61a1b2c3d4e5f6a7b8c9d0e1
+
+# Show the full stacktrace of the latest event
+$ bugsnag errors view 61a1b2c3d4e5f6a7b8c9d0e1 --frames 0`
+)
+
+// NewCmdView is a view command.
+func NewCmdView() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "view ERROR-ID",
+		Short:   "View displays details of an error",
+		Long:    "View displays details of an error along with the stacktrace of its latest event.",
+		Example: examples,
+		Aliases: []string{"show"},
+		Annotations: map[string]string{
+			"help:args": "ERROR-ID\tId of the error, eg: 61a1b2c3d4e5f6a7b8c9d0e1",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  view,
+	}
+
+	cmd.Flags().Int("frames", defaultFrames, "Number of stack frames to show, 0 shows all frames")
+
+	return &cmd
+}
+
+func view(cmd *cobra.Command, args []string) {
+	frames, err := cmd.Flags().GetInt("frames")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	e, ev, err := func() (*bugsnag.Error, *bugsnag.Event, error) {
+		s := cmdutil.Info("Fetching error details...")
+		defer s.Stop()
+
+		client := api.DefaultClient(viper.GetBool("debug"))
+
+		projectID, err := cmdutil.ResolveProjectID(client, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		e, err := client.GetError(projectID, args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		ev, err := client.GetLatestEvent(projectID, args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		return e, ev, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	data := struct {
+		*bugsnag.Error
+		LatestEvent *bugsnag.Event `json:"latest_event"`
+	}{e, ev}
+	v := tuiView.ErrorDetails{Error: e, Event: ev, Frames: frames}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, data, v))
+}
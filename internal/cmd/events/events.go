@@ -0,0 +1,35 @@
+package events
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/events/export"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/events/list"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/events/view"
+)
+
+const helpText = `Events inspects individual occurrences of errors. See available commands below.`
+
+// NewCmdEvents is an events command.
+func NewCmdEvents() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "events",
+		Short:       "Events inspects bugsnag events",
+		Long:        helpText,
+		Aliases:     []string{"event"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        events,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		view.NewCmdView(),
+		export.NewCmdExport(),
+	)
+
+	return &cmd
+}
+
+func events(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,267 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/export"
+	"github.com/teamupstart/bugsnag-data-cli/internal/query"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	defaultMaxLines = 100000
+	dirPerm         = 0o755
+
+	helpText = `Export writes every event of a project, or of an error, in a time range
+to newline delimited json files in the output directory.
+
+Files are named events-00001.ndjson, events-00002.ndjson and so on, and are
+rotated after --max-lines events. Progress is recorded in a .checkpoint.json
+file after every page, so running the same command again resumes an
+interrupted export from where it stopped.`
+
+	examples = `# Export last week's events
+$ bugsnag events export --since 7d --out events/
+
+# Export a day of production events as gzipped files
+$ bugsnag events export --since 2022-07-01 --until 2022-07-02 --filter stage=production --gzip --out july-1/
+
+# Start over, discarding a previous export in the directory
+$ bugsnag events export --since 7d --out events/ --restart`
+)
+
+// NewCmdExport is an export command.
+func NewCmdExport() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "export",
+		Short:   "Export dumps events to NDJSON files",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"dump"},
+		Run:     run,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("since", "", "Export events received after this time, eg: 2022-07-01, 2022-07-01T15:04:05Z or 7d")
+	cmd.Flags().String("until", "", "Export events received before this time, defaults to now")
+	cmd.Flags().String("out", "", "Directory to write the export to")
+	cmd.Flags().StringP("error", "e", "", "Export events of this error only")
+	cmd.Flags().StringToStringP("filter", "f", nil, query.FilterHelp("events", "event.since", "event.before"))
+	_ = cmd.RegisterFlagCompletionFunc("filter", cmdutil.CompleteFilter)
+	cmd.Flags().Bool("gzip", false, "Compress files with gzip")
+	cmd.Flags().Int("max-lines", defaultMaxLines, "Number of events per file, 0 writes a single file")
+	cmd.Flags().Bool("restart", false, "Ignore the checkpoint and start the export over")
+
+	_ = cmd.MarkFlagRequired("since")
+	_ = cmd.MarkFlagRequired("out")
+
+	return &cmd
+}
+
+type exportParams struct {
+	out      string
+	maxLines int
+	restart  bool
+	filters  bugsnag.Filters
+	want     *export.Checkpoint
+}
+
+func parseFlags(cmd *cobra.Command) (*exportParams, error) {
+	flags := cmd.Flags()
+
+	var (
+		params exportParams
+		want   export.Checkpoint
+		err    error
+	)
+
+	if params.out, err = flags.GetString("out"); err != nil {
+		return nil, err
+	}
+	if params.maxLines, err = flags.GetInt("max-lines"); err != nil {
+		return nil, err
+	}
+	if params.restart, err = flags.GetBool("restart"); err != nil {
+		return nil, err
+	}
+	if want.Since, err = flags.GetString("since"); err != nil {
+		return nil, err
+	}
+	if want.Until, err = flags.GetString("until"); err != nil {
+		return nil, err
+	}
+	if want.ErrorID, err = flags.GetString("error"); err != nil {
+		return nil, err
+	}
+	if want.Gzip, err = flags.GetBool("gzip"); err != nil {
+		return nil, err
+	}
+	if want.Filters, err = flags.GetStringToString("filter"); err != nil {
+		return nil, err
+	}
+	if params.filters, err = query.Filters(flags); err != nil {
+		return nil, err
+	}
+	for _, f := range []string{"event.since", "event.before"} {
+		if _, ok := params.filters[f]; ok {
+			return nil, fmt.Errorf("use --since and --until instead of filtering on %s", f)
+		}
+	}
+
+	now := time.Now()
+
+	from, err := query.ParseTime(want.Since, now)
+	if err != nil {
+		return nil, fmt.Errorf("--since: %w", err)
+	}
+	to := now
+	if want.Until != "" {
+		if to, err = query.ParseTime(want.Until, now); err != nil {
+			return nil, fmt.Errorf("--until: %w", err)
+		}
+	}
+	if !from.Before(to) {
+		return nil, fmt.Errorf("--since must be before --until")
+	}
+	want.From = from.UTC().Format(time.RFC3339)
+	want.To = to.UTC().Format(time.RFC3339)
+
+	params.want = &want
+
+	return &params, nil
+}
+
+func run(cmd *cobra.Command, _ []string) {
+	params, err := parseFlags(cmd)
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(viper.GetBool("debug"))
+
+	projectID, err := cmdutil.ResolveProjectID(client, "")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cmdutil.ValidateFilters(client, projectID, params.filters))
+	params.want.ProjectID = projectID
+
+	cp, err := checkpoint(params)
+	cmdutil.ExitIfError(err)
+
+	if cp.Complete {
+		cmdutil.Success("Export of %d events to %s is already complete, use --restart to export again", cp.Exported, params.out)
+		return
+	}
+	if cp.Exported > 0 {
+		cmdutil.Warn("Resuming export from the checkpoint, %d events already exported.", cp.Exported)
+	}
+
+	filters := params.filters
+	filters.Add("event.since", bugsnag.FilterTypeEq, cp.From)
+	filters.Add("event.before", bugsnag.FilterTypeEq, cp.To)
+
+	pager := client.RawEventsPager(projectID, cp.ErrorID, filters, &bugsnag.ListEventsOptions{
+		Direction:   "asc",
+		FullReports: true,
+	})
+	if cp.Cursor != "" {
+		cmdutil.ExitIfError(pager.Seek(cp.Cursor))
+	}
+
+	w, err := export.NewWriter(params.out, cp, params.maxLines)
+	cmdutil.ExitIfError(err)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Exported %d events...", cp.Exported))
+		defer s.Stop()
+
+		for pager.HasNext() {
+			events, err := pager.Next(ctx)
+			if err != nil {
+				return err
+			}
+			if err := w.WritePage(events); err != nil {
+				return err
+			}
+
+			if cp.Cursor, err = pager.Cursor(); err != nil {
+				return err
+			}
+			if err := cp.Save(params.out); err != nil {
+				return err
+			}
+
+			s.Lock()
+			s.Suffix = fmt.Sprintf(" Exported %d events to %s...", cp.Exported, filepath.Base(w.Path()))
+			s.Unlock()
+		}
+
+		cp.Complete = true
+		return cp.Save(params.out)
+	}()
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+
+	if errors.Is(err, context.Canceled) {
+		cmdutil.Failed("Interrupted after %d events. Run the same command again to resume.", cp.Exported)
+	}
+	if err != nil {
+		cmdutil.Fail("Export stopped after %d events. Run the same command again to resume.", cp.Exported)
+	}
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Exported %d events to %s", cp.Exported, params.out)
+}
+
+// checkpoint loads the checkpoint of a previous run in the output directory,
+// or prepares the directory for a new export.
+func checkpoint(params *exportParams) (*export.Checkpoint, error) {
+	if err := os.MkdirAll(params.out, dirPerm); err != nil {
+		return nil, err
+	}
+
+	if !params.restart {
+		cp, err := export.LoadCheckpoint(params.out)
+		if err != nil {
+			return nil, err
+		}
+		if cp != nil {
+			if !cp.SameExport(params.want) {
+				return nil, fmt.Errorf(
+					"%s contains a different export, use the same flags to resume it or --restart to discard it",
+					params.out,
+				)
+			}
+			return cp, nil
+		}
+	}
+
+	existing, err := filepath.Glob(filepath.Join(params.out, "events-*.ndjson*"))
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 && !params.restart {
+		return nil, fmt.Errorf("%s already contains exported files, use --restart to overwrite them", params.out)
+	}
+	for _, f := range existing {
+		if err := os.Remove(f); err != nil {
+			return nil, err
+		}
+	}
+
+	cp := *params.want
+	return &cp, cp.Save(params.out)
+}
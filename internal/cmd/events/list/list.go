@@ -0,0 +1,134 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	"github.com/teamupstart/bugsnag-data-cli/internal/query"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	maxMessageLength = 50
+	defaultLimit     = 100
+
+	examples = `# List the latest events of an error
+$ bugsnag events list --error 61a1b2c3d4e5f6a7b8c9d0e1
+
+# List production events in the project during an incident window
+$ bugsnag events list --filter stage=production --since 2022-07-01T14:00:00Z --until 2022-07-01T16:00:00Z
+
+# Events of a single user in the last day
+$ bugsnag events list --filter user=42 --since 1d`
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List lists events of an error or a project",
+		Long:    "List lists events of an error, or of the whole project if no error is given, newest first.",
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringP("error", "e", "", "List events of this error only")
+	cmd.Flags().StringToStringP("filter", "f", nil, query.FilterHelp("events"))
+	_ = cmd.RegisterFlagCompletionFunc("filter", cmdutil.CompleteFilter)
+	cmd.Flags().String("since", "", "Only events received after this time, eg: 2022-07-01, 2022-07-01T15:04:05Z or 7d")
+	cmd.Flags().String("until", "", "Only events received before this time, same formats as --since")
+	cmd.Flags().Bool("reverse", false, "List oldest events first")
+	cmd.Flags().Int("limit", defaultLimit, "Maximum number of events to fetch, 0 fetches all events")
+
+	return &cmd
+}
+
+// List displays a list of events.
+func List(cmd *cobra.Command, _ []string) {
+	flags := cmd.Flags()
+
+	errorID, err := flags.GetString("error")
+	cmdutil.ExitIfError(err)
+
+	filters, err := query.Filters(flags)
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(query.TimeRange(flags, filters, time.Now()))
+
+	reverse, err := flags.GetBool("reverse")
+	cmdutil.ExitIfError(err)
+
+	limit, err := flags.GetInt("limit")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(flags)
+	cmdutil.ExitIfError(err)
+
+	direction := "desc"
+	if reverse {
+		direction = "asc"
+	}
+
+	client := api.DefaultClient(viper.GetBool("debug"))
+
+	projectID, err := cmdutil.ResolveProjectID(client, "")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cmdutil.ValidateFilters(client, projectID, filters))
+
+	events, total, err := func() ([]*bugsnag.Event, int, error) {
+		s := cmdutil.Info("Fetching events...")
+		defer s.Stop()
+
+		pager := client.EventsPager(projectID, errorID, filters, &bugsnag.ListEventsOptions{
+			ListOptions: bugsnag.ListOptions{Limit: limit},
+			Direction:   direction,
+		})
+		events, err := bugsnag.Collect(context.Background(), pager, limit)
+
+		return events, pager.Total(), err
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(events) == 0 && out.IsHuman() {
+		fmt.Println()
+		cmdutil.Failed("No events found.")
+		return
+	}
+
+	table := tuiView.Table{
+		Header: []string{"ID", "ERROR ID", "CLASS", "MESSAGE", "CONTEXT", "SEVERITY", "UNHANDLED", "RECEIVED"},
+	}
+	for _, e := range events {
+		var class, message string
+		if ex := e.Exception(); ex != nil {
+			class, message = ex.ErrorClass, ex.Message
+		}
+		table.Rows = append(table.Rows, []string{
+			e.ID,
+			e.ErrorID,
+			tuiView.OrBlank(class),
+			tuiView.OrBlank(tuiView.Shorten(message, maxMessageLength)),
+			tuiView.OrBlank(e.Context),
+			e.Severity,
+			strconv.FormatBool(e.Unhandled),
+			cmdutil.FormatDateTimeHuman(e.ReceivedAt, bugsnag.ISO8601),
+		})
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, events, &table))
+	if out.IsHuman() {
+		tuiView.Footer(os.Stderr, len(events), total, "events")
+	}
+}
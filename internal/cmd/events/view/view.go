@@ -0,0 +1,92 @@
+package view
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	defaultFrames = 10
+
+	examples = `$ bugsnag events view 62b1c2d3e4f5a6b7c8d9e0f1
+
+# Print only the breadcrumbs
+$ bugsnag events view 62b1c2d3e4f5a6b7c8d9e0f1 --section breadcrumbs
+
+# Dump metadata as json
+$ bugsnag events view 62b1c2d3e4f5a6b7c8d9e0f1 --section metadata -o json`
+)
+
+// NewCmdView is a view command.
+func NewCmdView() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "view EVENT-ID",
+		Short: "View displays the full payload of an event",
+		Long: `View displays the full payload of an event: exceptions, threads, breadcrumbs,
+request, user, device, app and metadata.`,
+		Example: examples,
+		Aliases: []string{"show"},
+		Annotations: map[string]string{
+			"help:args": "EVENT-ID\tId of the event, see 'bugsnag events list'",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  view,
+	}
+
+	cmd.Flags().String("section", "", "Show a single section, one of: "+strings.Join(tuiView.EventSections, ", "))
+	cmd.Flags().Int("frames", defaultFrames, "Number of stack frames to show, 0 shows all frames")
+
+	return &cmd
+}
+
+func view(cmd *cobra.Command, args []string) {
+	section, err := cmd.Flags().GetString("section")
+	cmdutil.ExitIfError(err)
+
+	section = strings.ToLower(section)
+	if section != "" && !isSection(section) {
+		cmdutil.Failed("Invalid section %q. Must be one of: %s.", section, strings.Join(tuiView.EventSections, ", "))
+	}
+
+	frames, err := cmd.Flags().GetInt("frames")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	ev, err := func() (*bugsnag.Event, error) {
+		s := cmdutil.Info("Fetching event...")
+		defer s.Stop()
+
+		client := api.DefaultClient(viper.GetBool("debug"))
+
+		projectID, err := cmdutil.ResolveProjectID(client, "")
+		if err != nil {
+			return nil, err
+		}
+		return client.GetEvent(projectID, args[0])
+	}()
+	cmdutil.ExitIfError(err)
+
+	details := tuiView.EventDetails{Event: ev, Frames: frames, Section: section}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, details.Data(), details))
+}
+
+func isSection(s string) bool {
+	for _, section := range tuiView.EventSections {
+		if s == section {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package fields
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/fields/list"
+)
+
+const helpText = `Fields shows event fields of a project that can be used in filters and pivots.
+See available commands below.`
+
+// NewCmdFields is a fields command.
+func NewCmdFields() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "fields",
+		Short:       "Fields shows filterable event fields",
+		Long:        helpText,
+		Aliases:     []string{"field"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        fields,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+	)
+
+	return &cmd
+}
+
+func fields(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
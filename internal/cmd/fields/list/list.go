@@ -0,0 +1,104 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const examples = `$ bugsnag fields list
+
+# Only custom metadata fields
+$ bugsnag fields list --custom`
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "list",
+		Short: "List lists event fields of a project",
+		Long: `List lists event fields of a project. Use the field ids as --filter keys
+and pivots fields. The list is also cached to validate --filter keys.`,
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+
+	cmd.Flags().Bool("custom", false, "Show only custom fields")
+
+	return &cmd
+}
+
+// List displays a list of event fields.
+func List(cmd *cobra.Command, _ []string) {
+	custom, err := cmd.Flags().GetBool("custom")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	fields, err := func() ([]*bugsnag.EventField, error) {
+		s := cmdutil.Info("Fetching event fields...")
+		defer s.Stop()
+
+		client := api.DefaultClient(viper.GetBool("debug"))
+
+		projectID, err := cmdutil.ResolveProjectID(client, "")
+		if err != nil {
+			return nil, err
+		}
+
+		fields, err := client.ListEventFields(projectID)
+		if err != nil {
+			return nil, err
+		}
+		cmdutil.CacheEventFields(projectID, fields)
+
+		return fields, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	if custom {
+		filtered := fields[:0]
+		for _, f := range fields {
+			if f.Custom {
+				filtered = append(filtered, f)
+			}
+		}
+		fields = filtered
+	}
+
+	if len(fields) == 0 && out.IsHuman() {
+		fmt.Println()
+		cmdutil.Failed("No event fields found.")
+		return
+	}
+
+	table := tuiView.Table{
+		Header: []string{"FIELD", "NAME", "CUSTOM", "PIVOTABLE", "MATCH TYPES"},
+	}
+	for _, f := range fields {
+		var matchTypes []string
+		if f.FilterOptions != nil {
+			matchTypes = f.FilterOptions.MatchTypes
+		}
+		table.Rows = append(table.Rows, []string{
+			f.DisplayID,
+			tuiView.OrBlank(f.Name()),
+			strconv.FormatBool(f.Custom),
+			strconv.FormatBool(f.Pivotable()),
+			tuiView.OrBlank(strings.Join(matchTypes, ",")),
+		})
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, fields, &table))
+}
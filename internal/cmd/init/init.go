@@ -16,15 +16,24 @@ import (
 type initParams struct {
 	api_endpoint string
 	login        string
+	organization string
+	project      string
 	force        bool
 }
 
+const examples = `# Generate config interactively
+$ bugsnag init
+
+# Generate config without any prompts
+$ bugsnag init --api_endpoint https://api.bugsnag.com --login jane@example.com --organization upstart --project web-app`
+
 // NewCmdInit is an init command.
 func NewCmdInit() *cobra.Command {
 	cmd := cobra.Command{
 		Use:     "init",
 		Short:   "Init initializes bugsnag config",
 		Long:    "Init initializes bugsnag configuration required for the tool to work properly.",
+		Example: examples,
 		Aliases: []string{"initialize", "configure", "config", "setup"},
 		Run:     initialize,
 	}
@@ -33,6 +42,7 @@ func NewCmdInit() *cobra.Command {
 
 	cmd.Flags().String("api_endpoint", "", "Link to your bugsnag api endpoint")
 	cmd.Flags().String("login", "", "Bugsnag api token")
+	cmd.Flags().String("organization", "", "Default bugsnag organization id, slug or name")
 	cmd.Flags().Bool("force", false, "Forcefully override existing config if it exists")
 
 	return &cmd
@@ -45,12 +55,20 @@ func parseFlags(flags query.FlagParser) *initParams {
 	login, err := flags.GetString("login")
 	cmdutil.ExitIfError(err)
 
+	organization, err := flags.GetString("organization")
+	cmdutil.ExitIfError(err)
+
+	project, err := flags.GetString("project")
+	cmdutil.ExitIfError(err)
+
 	force, err := flags.GetBool("force")
 	cmdutil.ExitIfError(err)
 
 	return &initParams{
 		api_endpoint: api_endpoint,
 		login:        login,
+		organization: organization,
+		project:      project,
 		force:        force,
 	}
 }
@@ -60,9 +78,11 @@ func initialize(cmd *cobra.Command, _ []string) {
 
 	c := bugsnagConfig.NewBugsnagCLIConfigGenerator(
 		&bugsnagConfig.BugsnagCLIConfig{
-			APIEndpoint: params.api_endpoint,
-			Login:       params.login,
-			Force:       params.force,
+			APIEndpoint:  params.api_endpoint,
+			Login:        params.login,
+			Organization: params.organization,
+			Project:      params.project,
+			Force:        params.force,
 		},
 	)
 
@@ -1,10 +1,14 @@
 package me
 
 import (
-	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
 )
 
 // NewCmdMe is a me command.
@@ -17,6 +21,15 @@ func NewCmdMe() *cobra.Command {
 	}
 }
 
-func me(*cobra.Command, []string) {
-	fmt.Println(viper.GetString("login"))
+func me(cmd *cobra.Command, _ []string) {
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	login := viper.GetString("login")
+
+	data := struct {
+		Login string `json:"login"`
+	}{login}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, data, view.Value{Name: "LOGIN", Value: login}))
 }
@@ -0,0 +1,226 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/version"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	helpText = `Notify sends an event to the bugsnag error reporting api, eg: to check that
+alerts and integrations fire after a configuration change.
+
+The event is built from the flags below, or a complete json payload is read
+from a file, or stdin, with --payload. Metadata keys may be prefixed with a
+tab name, eg: --metadata deploy.region=eu, and default to the custom tab.
+
+Events are authenticated with the notifier api key of the project, which can
+also be set with the BUGSNAG_API_KEY env variable. No api token is needed.`
+
+	examples = `# Send a test event
+$ bugsnag notify --api-key $BUGSNAG_API_KEY --message "Checking slack alerts"
+
+# Send an error with user and metadata to staging
+$ bugsnag notify --error-class PaymentError --message "Card declined" --severity error \
+    --user 42 --app-version 2.4.1 --release-stage staging --metadata payment.provider=stripe
+
+# Send a payload to a local stub
+$ bugsnag notify --payload event.json --endpoint http://localhost:9090
+$ cat event.json | bugsnag notify --payload -`
+
+	defaultErrorClass = "BugsnagTestError"
+	defaultMessage    = "Test event sent with bugsnag cli"
+	defaultMetaTab    = "custom"
+)
+
+// eventFlags build the event and cannot be combined with --payload.
+var eventFlags = []string{
+	"error-class", "message", "severity", "context", "metadata", "user", "app-version", "release-stage",
+}
+
+// NewCmdNotify is a notify command.
+func NewCmdNotify() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "notify",
+		Short:   "Notify sends a test event to bugsnag",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"cmd:main":     "true",
+			"cmd:no-token": "true",
+		},
+		Args: cobra.NoArgs,
+		Run:  notify,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("api-key", "", "Notifier api key of the project (default $BUGSNAG_API_KEY)")
+	cmd.Flags().String("error-class", defaultErrorClass, "Class of the error")
+	cmd.Flags().String("message", defaultMessage, "Error message")
+	cmd.Flags().String("severity", bugsnag.SeverityWarning, "Severity of the event, one of: error, warning, info")
+	cmd.Flags().String("context", "", "Context of the event, eg: a route or a job name")
+	cmd.Flags().StringToString("metadata", nil, "Metadata of the event as [tab.]key=value, can be repeated")
+	cmd.Flags().String("user", "", "Id of the affected user")
+	cmd.Flags().String("app-version", "", "Version of the app the event happened in")
+	cmd.Flags().String("release-stage", "", "Release stage of the app, eg: production")
+	cmd.Flags().String("payload", "", "Send a json payload from a file as is, use - for stdin")
+	cmd.Flags().String(
+		"endpoint", "", "Error reporting api endpoint (default $BUGSNAG_NOTIFY_ENDPOINT or "+bugsnag.DefaultNotifyEndpoint+")",
+	)
+
+	return &cmd
+}
+
+func notify(cmd *cobra.Command, _ []string) {
+	flags := cmd.Flags()
+
+	apiKey, err := flags.GetString("api-key")
+	cmdutil.ExitIfError(err)
+	if apiKey == "" {
+		apiKey = viper.GetString("api_key")
+	}
+
+	payloadFile, err := flags.GetString("payload")
+	cmdutil.ExitIfError(err)
+
+	endpoint, err := flags.GetString("endpoint")
+	cmdutil.ExitIfError(err)
+
+	var (
+		payload []byte
+		n       *bugsnag.Notification
+	)
+	switch {
+	case payloadFile != "":
+		payload, apiKey, err = readPayload(flags, payloadFile, apiKey)
+	case apiKey == "":
+		err = fmt.Errorf("no api key specified, please pass one with --api-key or set BUGSNAG_API_KEY")
+	default:
+		n, err = notification(flags, apiKey)
+	}
+	cmdutil.ExitIfError(err)
+
+	err = func() error {
+		s := cmdutil.Info("Sending event...")
+		defer s.Stop()
+
+		client := api.NotifierClient(bugsnag.Config{NotifyEndpoint: endpoint, Debug: viper.GetBool("debug")})
+
+		if n != nil {
+			return client.Notify(n)
+		}
+		return client.NotifyRaw(apiKey, payload)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Event sent to bugsnag")
+}
+
+func notification(flags *pflag.FlagSet, apiKey string) (*bugsnag.Notification, error) {
+	var (
+		ex    = bugsnag.NotifyException{Stacktrace: []*bugsnag.NotifyFrame{}}
+		event = bugsnag.NotifyEvent{
+			Exceptions:     []*bugsnag.NotifyException{&ex},
+			SeverityReason: &bugsnag.SeverityReason{Type: "userSpecifiedSeverity"},
+		}
+		user bugsnag.NotifyUser
+		app  bugsnag.NotifyApp
+		err  error
+	)
+	for name, dst := range map[string]*string{
+		"error-class":   &ex.ErrorClass,
+		"message":       &ex.Message,
+		"severity":      &event.Severity,
+		"context":       &event.Context,
+		"user":          &user.ID,
+		"app-version":   &app.Version,
+		"release-stage": &app.ReleaseStage,
+	} {
+		if *dst, err = flags.GetString(name); err != nil {
+			return nil, err
+		}
+	}
+
+	if user.ID != "" {
+		event.User = &user
+	}
+	if app.Version != "" || app.ReleaseStage != "" {
+		event.App = &app
+	}
+
+	metadata, err := flags.GetStringToString("metadata")
+	if err != nil {
+		return nil, err
+	}
+	event.MetaData = metaData(metadata)
+
+	n := bugsnag.Notification{
+		APIKey:   apiKey,
+		Notifier: bugsnag.DefaultNotifier(version.Version),
+		Events:   []*bugsnag.NotifyEvent{&event},
+	}
+	if err := n.Validate(); err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// metaData groups key=value pairs by tab, keys without a tab go to the custom tab.
+func metaData(pairs map[string]string) map[string]map[string]interface{} {
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	out := make(map[string]map[string]interface{})
+	for k, v := range pairs {
+		tab, key := defaultMetaTab, k
+		if i := strings.Index(k, "."); i > 0 && i < len(k)-1 {
+			tab, key = k[:i], k[i+1:]
+		}
+		if out[tab] == nil {
+			out[tab] = make(map[string]interface{})
+		}
+		out[tab][key] = v
+	}
+	return out
+}
+
+// readPayload reads a json payload from the file. The api key is read from
+// the payload if it isn't given.
+func readPayload(flags *pflag.FlagSet, file, apiKey string) ([]byte, string, error) {
+	for _, name := range eventFlags {
+		if flags.Changed(name) {
+			return nil, "", fmt.Errorf("--payload cannot be used together with --%s", name)
+		}
+	}
+
+	b, err := cmdutil.ReadFile(file)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var p struct {
+		APIKey string `json:"apiKey"`
+	}
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, "", fmt.Errorf("invalid payload: %w", err)
+	}
+	if apiKey == "" {
+		apiKey = p.APIKey
+	}
+	if apiKey == "" {
+		return nil, "", fmt.Errorf("no api key specified, please pass one with --api-key or set it in the payload")
+	}
+
+	return b, apiKey, nil
+}
@@ -0,0 +1,72 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List lists bugsnag organizations",
+		Long:    "List lists bugsnag organizations that the configured user has access to.",
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+
+	cmd.Flags().Int("limit", 0, "Maximum number of organizations to fetch, 0 fetches all")
+
+	return &cmd
+}
+
+// List displays a list of organizations.
+func List(cmd *cobra.Command, _ []string) {
+	limit, err := cmd.Flags().GetInt("limit")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	orgs, total, err := func() ([]*bugsnag.Organization, int, error) {
+		s := cmdutil.Info("Fetching organizations...")
+		defer s.Stop()
+
+		pager := api.DefaultClient(viper.GetBool("debug")).OrganizationsPager(&bugsnag.ListOptions{Limit: limit})
+		orgs, err := bugsnag.Collect(context.Background(), pager, limit)
+
+		return orgs, pager.Total(), err
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(orgs) == 0 && out.IsHuman() {
+		fmt.Println()
+		cmdutil.Failed("No organizations found.")
+		return
+	}
+
+	table := tuiView.Table{Header: []string{"ID", "NAME", "SLUG", "CREATED"}}
+	for _, o := range orgs {
+		table.Rows = append(table.Rows, []string{
+			o.ID,
+			o.Name,
+			o.Slug,
+			cmdutil.FormatDateTimeHuman(o.CreatedAt, bugsnag.ISO8601),
+		})
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, orgs, &table))
+	if out.IsHuman() {
+		tuiView.Footer(os.Stderr, len(orgs), total, "organizations")
+	}
+}
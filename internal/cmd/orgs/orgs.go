@@ -0,0 +1,33 @@
+package orgs
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/orgs/list"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/orgs/view"
+)
+
+const helpText = `Orgs manages bugsnag organizations. See available commands below.`
+
+// NewCmdOrgs is an orgs command.
+func NewCmdOrgs() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "orgs",
+		Short:       "Orgs manages bugsnag organizations",
+		Long:        helpText,
+		Aliases:     []string{"org", "organizations", "organization"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        orgs,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		view.NewCmdView(),
+	)
+
+	return &cmd
+}
+
+func orgs(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,71 @@
+package view
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const examples = `$ bugsnag orgs view 5f0c1a2b3c4d5e6f7a8b9c0d
+
+# View organization by its slug
+$ bugsnag orgs view my-org -o json`
+
+// NewCmdView is a view command.
+func NewCmdView() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "view ORG",
+		Short:   "View displays details of an organization",
+		Long:    "View displays details of a bugsnag organization.",
+		Example: examples,
+		Aliases: []string{"show"},
+		Annotations: map[string]string{
+			"help:args": "ORG\tOrganization id, slug or name, eg: my-org",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  view,
+	}
+
+	return &cmd
+}
+
+func view(cmd *cobra.Command, args []string) {
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	org, err := func() (*bugsnag.Organization, error) {
+		s := cmdutil.Info("Fetching organization details...")
+		defer s.Stop()
+
+		return cmdutil.ResolveOrganization(api.DefaultClient(viper.GetBool("debug")), args[0])
+	}()
+	cmdutil.ExitIfError(err)
+
+	var creator string
+	if org.Creator != nil {
+		creator = org.Creator.Name
+		if org.Creator.Email != "" {
+			creator += " <" + org.Creator.Email + ">"
+		}
+	}
+
+	details := tuiView.Details{
+		{Label: "ID", Value: org.ID},
+		{Label: "Name", Value: org.Name},
+		{Label: "Slug", Value: org.Slug},
+		{Label: "Creator", Value: creator},
+		{Label: "Billing emails", Value: strings.Join(org.BillingEmails, ", ")},
+		{Label: "Created", Value: cmdutil.FormatDateTimeHuman(org.CreatedAt, bugsnag.ISO8601)},
+		{Label: "Updated", Value: cmdutil.FormatDateTimeHuman(org.UpdatedAt, bugsnag.ISO8601)},
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, org, details))
+}
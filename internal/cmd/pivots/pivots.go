@@ -0,0 +1,193 @@
+package pivots
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	"github.com/teamupstart/bugsnag-data-cli/internal/query"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	defaultLimit     = 10
+	maxTopValueWidth = 40
+
+	helpText = `Pivots shows the distribution of an event field, like app version, operating
+system or browser, across events of an error or of the whole project.
+
+Use --list to see the fields that can be pivoted on.`
+
+	examples = `# Which app versions does this error happen on?
+$ bugsnag pivots app.version --error 61a1b2c3d4e5f6a7b8c9d0e1
+
+# Operating systems of production events in the last day
+$ bugsnag pivots device.os_name --filter stage=production --since 1d
+
+# List pivotable fields of an error
+$ bugsnag pivots --list --error 61a1b2c3d4e5f6a7b8c9d0e1`
+)
+
+// NewCmdPivots is a pivots command.
+func NewCmdPivots() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "pivots [FIELD]",
+		Short:       "Pivots shows top values of an event field",
+		Long:        helpText,
+		Example:     examples,
+		Aliases:     []string{"pivot"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		Args:        cobra.MaximumNArgs(1),
+		Run:         pivots,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().Bool("list", false, "List fields that can be pivoted on")
+	cmd.Flags().StringP("error", "e", "", "Pivot on events of this error only")
+	cmd.Flags().StringToStringP("filter", "f", nil, query.FilterHelp("events"))
+	_ = cmd.RegisterFlagCompletionFunc("filter", cmdutil.CompleteFilter)
+	cmd.Flags().String("since", "", "Only events received after this time, eg: 2022-07-01, 2022-07-01T15:04:05Z or 7d")
+	cmd.Flags().String("until", "", "Only events received before this time, same formats as --since")
+	cmd.Flags().Int("limit", defaultLimit, "Maximum number of values to show, 0 shows all values")
+
+	return &cmd
+}
+
+func pivots(cmd *cobra.Command, args []string) {
+	flags := cmd.Flags()
+
+	list, err := flags.GetBool("list")
+	cmdutil.ExitIfError(err)
+
+	switch {
+	case list && len(args) > 0:
+		cmdutil.Failed("Error: FIELD and --list cannot be used together")
+	case !list && len(args) == 0:
+		cmdutil.Failed("Error: either a FIELD or --list is required")
+	}
+
+	errorID, err := flags.GetString("error")
+	cmdutil.ExitIfError(err)
+
+	limit, err := flags.GetInt("limit")
+	cmdutil.ExitIfError(err)
+
+	filters, err := query.Filters(flags)
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(query.TimeRange(flags, filters, time.Now()))
+
+	out, err := output.FromFlags(flags)
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(viper.GetBool("debug"))
+
+	projectID, err := cmdutil.ResolveProjectID(client, "")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cmdutil.ValidateFilters(client, projectID, filters))
+
+	if list {
+		listPivots(client, out, projectID, errorID, filters)
+		return
+	}
+
+	field := query.FilterField(args[0])
+
+	values, total, err := func() ([]*bugsnag.PivotValue, int, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching values of %s...", field))
+		defer s.Stop()
+
+		pager := client.PivotValuesPager(projectID, errorID, field, filters, &bugsnag.ListOptions{Limit: limit})
+
+		values, err := bugsnag.Collect(context.Background(), pager, limit)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// Percentages are only shown by the view and, if all values are
+		// fetched, the total is their sum. Otherwise it is in the pivot summary.
+		switch {
+		case out.IsPayload():
+			return values, 0, nil
+		case !pager.HasNext() && (limit < 1 || len(values) < limit):
+			return values, totalEvents(nil, field, values), nil
+		}
+
+		pivots, err := client.ListPivots(projectID, errorID, filters)
+		if err != nil {
+			return nil, 0, err
+		}
+		return values, totalEvents(pivots, field, values), nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(values) == 0 && out.IsHuman() {
+		fmt.Println()
+		cmdutil.Failed("No values found for %s.", field)
+		return
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, values, view.PivotValues{Values: values, Total: total}))
+}
+
+func listPivots(client *bugsnag.Client, out *output.Options, projectID, errorID string, filters bugsnag.Filters) {
+	pivots, err := func() ([]*bugsnag.Pivot, error) {
+		s := cmdutil.Info("Fetching pivots...")
+		defer s.Stop()
+
+		return client.ListPivots(projectID, errorID, filters)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(pivots) == 0 && out.IsHuman() {
+		fmt.Println()
+		cmdutil.Failed("No pivots found.")
+		return
+	}
+
+	table := view.Table{Header: []string{"FIELD", "NAME", "VALUES", "TOP VALUE"}}
+	for _, p := range pivots {
+		top := ""
+		if p.Summary != nil && len(p.Summary.List) > 0 {
+			top = view.Shorten(p.Summary.List[0].Value, maxTopValueWidth)
+		}
+		table.Rows = append(table.Rows, []string{
+			p.EventFieldDisplayID,
+			p.Name,
+			strconv.Itoa(p.Cardinality),
+			view.OrBlank(top),
+		})
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, pivots, &table))
+}
+
+// totalEvents returns number of events with any value of the field using the pivot
+// summary. Events of the fetched values are summed up if the summary is missing.
+func totalEvents(pivots []*bugsnag.Pivot, field string, values []*bugsnag.PivotValue) int {
+	var total int
+
+	for _, p := range pivots {
+		if p.EventFieldDisplayID != field || p.Summary == nil {
+			continue
+		}
+		for _, v := range p.Summary.List {
+			total += v.Events
+		}
+		return total + p.Summary.Other
+	}
+
+	for _, v := range values {
+		total += v.Events
+	}
+	return total
+}
@@ -0,0 +1,89 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List lists bugsnag projects",
+		Long:    "List lists bugsnag projects in an organization.",
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+
+	cmd.Flags().Int("limit", 0, "Maximum number of projects to fetch, 0 fetches all")
+
+	return &cmd
+}
+
+// List displays a list of projects.
+func List(cmd *cobra.Command, _ []string) {
+	org, err := cmd.Flags().GetString("org")
+	cmdutil.ExitIfError(err)
+
+	limit, err := cmd.Flags().GetInt("limit")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	projects, total, err := func() ([]*bugsnag.Project, int, error) {
+		s := cmdutil.Info("Fetching projects...")
+		defer s.Stop()
+
+		client := api.DefaultClient(viper.GetBool("debug"))
+
+		o, err := cmdutil.ResolveOrganization(client, org)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		pager := client.ProjectsPager(o.ID, &bugsnag.ListOptions{Limit: limit})
+		projects, err := bugsnag.Collect(context.Background(), pager, limit)
+
+		return projects, pager.Total(), err
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(projects) == 0 && out.IsHuman() {
+		fmt.Println()
+		cmdutil.Failed("No projects found.")
+		return
+	}
+
+	table := tuiView.Table{
+		Header: []string{"ID", "NAME", "SLUG", "LANGUAGE", "RELEASE STAGES", "OPEN ERRORS", "API KEY"},
+	}
+	for _, p := range projects {
+		table.Rows = append(table.Rows, []string{
+			p.ID,
+			p.Name,
+			p.Slug,
+			tuiView.OrBlank(p.Language),
+			tuiView.OrBlank(strings.Join(p.ReleaseStages, ",")),
+			strconv.Itoa(p.OpenErrorCount),
+			p.APIKey,
+		})
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, projects, &table))
+	if out.IsHuman() {
+		tuiView.Footer(os.Stderr, len(projects), total, "projects")
+	}
+}
@@ -0,0 +1,35 @@
+package projects
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/projects/list"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/projects/view"
+)
+
+const helpText = `Projects manages bugsnag projects. See available commands below.`
+
+// NewCmdProjects is a projects command.
+func NewCmdProjects() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "projects",
+		Short:       "Projects manages bugsnag projects",
+		Long:        helpText,
+		Aliases:     []string{"project"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        projects,
+	}
+
+	cmd.PersistentFlags().String("org", "", "Organization id, slug or name (defaults to the configured organization)")
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		view.NewCmdView(),
+	)
+
+	return &cmd
+}
+
+func projects(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,80 @@
+package view
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const examples = `# View the configured project
+$ bugsnag projects view
+
+# View project by its id or slug
+$ bugsnag projects view 5f0c1a2b3c4d5e6f7a8b9c0d
+$ bugsnag projects view web-app --org upstart`
+
+// NewCmdView is a view command.
+func NewCmdView() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "view [PROJECT]",
+		Short:   "View displays details of a project",
+		Long:    "View displays details of a bugsnag project.",
+		Example: examples,
+		Aliases: []string{"show"},
+		Annotations: map[string]string{
+			"help:args": "PROJECT\tProject id, slug or name (defaults to the configured project)",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  view,
+	}
+
+	return &cmd
+}
+
+func view(cmd *cobra.Command, args []string) {
+	org, err := cmd.Flags().GetString("org")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	var ref string
+	if len(args) > 0 {
+		ref = args[0]
+	}
+
+	project, err := func() (*bugsnag.Project, error) {
+		s := cmdutil.Info("Fetching project details...")
+		defer s.Stop()
+
+		return cmdutil.ResolveProject(api.DefaultClient(viper.GetBool("debug")), org, ref)
+	}()
+	cmdutil.ExitIfError(err)
+
+	details := tuiView.Details{
+		{Label: "ID", Value: project.ID},
+		{Label: "Name", Value: project.Name},
+		{Label: "Slug", Value: project.Slug},
+		{Label: "Type", Value: project.Type},
+		{Label: "Language", Value: project.Language},
+		{Label: "Release stages", Value: strings.Join(project.ReleaseStages, ", ")},
+		{Label: "Open errors", Value: strconv.Itoa(project.OpenErrorCount)},
+		{Label: "For review", Value: strconv.Itoa(project.ForReviewErrorCount)},
+		{Label: "Collaborators", Value: strconv.Itoa(project.CollaboratorsCount)},
+		{Label: "API key", Value: project.APIKey},
+		{Label: "URL", Value: project.HTMLURL},
+		{Label: "Created", Value: cmdutil.FormatDateTimeHuman(project.CreatedAt, bugsnag.ISO8601)},
+		{Label: "Updated", Value: cmdutil.FormatDateTimeHuman(project.UpdatedAt, bugsnag.ISO8601)},
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, project, details))
+}
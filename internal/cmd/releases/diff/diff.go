@@ -0,0 +1,123 @@
+package diff
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	"github.com/teamupstart/bugsnag-data-cli/internal/release"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	helpText = `Diff compares errors seen in two app versions of the configured project.
+
+It lists errors first seen in the later version, errors that came back in it
+after not being seen in the earlier version, errors of the earlier version
+that are no longer seen, and the change in events of errors seen in both.`
+
+	examples = `# Compare two production releases
+$ bugsnag releases diff 2.4.0 2.4.1 --stage production
+
+# Paste the comparison in release notes
+$ bugsnag releases diff 2.4.0 2.4.1 --stage production -o markdown
+
+# Only list errors new in the later release
+$ bugsnag releases diff 2.4.0 2.4.1 --jq '.new[].error.id'`
+)
+
+// NewCmdDiff is a diff command.
+func NewCmdDiff() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "diff FROM-VERSION TO-VERSION",
+		Short:   "Diff compares errors of two releases",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"compare"},
+		Annotations: map[string]string{
+			"help:args": "FROM-VERSION\tApp version to compare against, eg: 2.4.0\n" +
+				"TO-VERSION\tApp version being compared, eg: 2.4.1",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  diff,
+	}
+
+	return &cmd
+}
+
+func diff(cmd *cobra.Command, args []string) {
+	stage, err := cmd.Flags().GetString("stage")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	from, to := args[0], args[1]
+	if from == to {
+		cmdutil.Failed("Nothing to compare, both versions are %s.", from)
+	}
+
+	d, err := func() (*release.Diff, error) {
+		s := cmdutil.Info("Comparing releases...")
+		defer s.Stop()
+
+		client := api.DefaultClient(viper.GetBool("debug"))
+
+		projectID, err := cmdutil.ResolveProjectID(client, "")
+		if err != nil {
+			return nil, err
+		}
+
+		return compare(client, projectID, stage, from, to)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, d, tuiView.ReleaseDiff{Diff: d, Stage: stage}))
+}
+
+// compare diffs errors of two app versions. Both versions must be releases of the
+// project, otherwise a mistyped version would report every error as new or fixed.
+func compare(client *bugsnag.Client, projectID, stage, from, to string) (*release.Diff, error) {
+	releases, err := client.ListReleases(projectID, stage, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]bool, len(releases))
+	for _, r := range releases {
+		versions[r.AppVersion] = true
+	}
+	for _, v := range []string{from, to} {
+		if !versions[v] {
+			return nil, fmt.Errorf("release %q not found, run 'bugsnag releases list' to see available releases", v)
+		}
+	}
+
+	before, err := listErrors(client, projectID, stage, from)
+	if err != nil {
+		return nil, err
+	}
+	after, err := listErrors(client, projectID, stage, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return release.Compare(from, to, before, after), nil
+}
+
+// listErrors fetches all errors seen in the app version, with event counts of that version only.
+func listErrors(client *bugsnag.Client, projectID, stage, version string) ([]*bugsnag.Error, error) {
+	filters := make(bugsnag.Filters)
+	filters.Add("app.version", bugsnag.FilterTypeEq, version)
+	if stage != "" {
+		filters.Add("app.release_stage", bugsnag.FilterTypeEq, stage)
+	}
+
+	return client.ListErrors(projectID, filters, &bugsnag.ListErrorsOptions{Sort: bugsnag.ErrorSortEvents})
+}
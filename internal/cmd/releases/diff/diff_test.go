@@ -0,0 +1,80 @@
+package diff
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+func TestCompare(t *testing.T) {
+	var releaseRequests, errorRequests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/projects/p1/releases":
+			releaseRequests++
+
+			assert.Equal(t, "production", r.URL.Query().Get("release_stage"))
+			_, _ = w.Write([]byte(`[{"id": "r2", "app_version": "2.4.1"}, {"id": "r1", "app_version": "2.4.0"}]`))
+		case "/projects/p1/errors":
+			errorRequests++
+
+			switch r.URL.Query().Get("filters[app.version][][value]") {
+			case "2.4.0":
+				_, _ = w.Write([]byte(`[{"id": "a", "events": 10}, {"id": "b", "events": 5}]`))
+			case "2.4.1":
+				_, _ = w.Write([]byte(`[{"id": "a", "events": 4}]`))
+			default:
+				_, _ = w.Write([]byte(`[]`))
+			}
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := bugsnag.NewClient(bugsnag.Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	cases := []struct {
+		name     string
+		from, to string
+		err      string
+	}{
+		{
+			name: "it rejects unknown from version",
+			from: "2.3.9", to: "2.4.1",
+			err: `release "2.3.9" not found, run 'bugsnag releases list' to see available releases`,
+		},
+		{
+			name: "it rejects unknown to version",
+			from: "2.4.0", to: "2.4.2",
+			err: `release "2.4.2" not found, run 'bugsnag releases list' to see available releases`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := compare(client, "p1", "production", tc.from, tc.to)
+			assert.Nil(t, d)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+	assert.Zero(t, errorRequests)
+
+	d, err := compare(client, "p1", "production", "2.4.0", "2.4.1")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, releaseRequests)
+	assert.Equal(t, 2, errorRequests)
+	assert.Len(t, d.Fixed, 1)
+	assert.Equal(t, "b", d.Fixed[0].Error.ID)
+	assert.Len(t, d.Changed, 1)
+	assert.Equal(t, -6, d.Changed[0].Delta())
+}
@@ -0,0 +1,110 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	defaultLimit   = 30
+	revisionLength = 7
+
+	examples = `# List latest production releases
+$ bugsnag releases list --stage production
+
+# List the ten latest releases of any stage as csv
+$ bugsnag releases list --limit 10 -o csv`
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List lists project releases",
+		Long:    "List lists releases of the configured project, newest first, with their session counts and stability.",
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+
+	cmd.Flags().Int("limit", defaultLimit, "Maximum number of releases to fetch, 0 fetches all")
+
+	return &cmd
+}
+
+// List displays a list of releases.
+func List(cmd *cobra.Command, _ []string) {
+	stage, err := cmd.Flags().GetString("stage")
+	cmdutil.ExitIfError(err)
+
+	limit, err := cmd.Flags().GetInt("limit")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	releases, total, err := func() ([]*bugsnag.Release, int, error) {
+		s := cmdutil.Info("Fetching releases...")
+		defer s.Stop()
+
+		client := api.DefaultClient(viper.GetBool("debug"))
+
+		projectID, err := cmdutil.ResolveProjectID(client, "")
+		if err != nil {
+			return nil, 0, err
+		}
+
+		pager := client.ReleasesPager(projectID, stage, &bugsnag.ListOptions{Limit: limit})
+		releases, err := bugsnag.Collect(context.Background(), pager, limit)
+
+		return releases, pager.Total(), err
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(releases) == 0 && out.IsHuman() {
+		fmt.Println()
+		cmdutil.Failed("No releases found.")
+		return
+	}
+
+	table := tuiView.Table{
+		Header: []string{
+			"ID", "VERSION", "STAGE", "RELEASED", "REVISION", "SESSIONS", "CRASH-FREE SESSIONS", "CRASH-FREE USERS",
+		},
+	}
+	for _, r := range releases {
+		var revision string
+		if r.SourceControl != nil {
+			revision = r.SourceControl.Revision
+			if len(revision) > revisionLength {
+				revision = revision[:revisionLength]
+			}
+		}
+		table.Rows = append(table.Rows, []string{
+			r.ID,
+			r.AppVersion,
+			r.ReleaseStage,
+			cmdutil.FormatDateTimeHuman(r.ReleaseTime, bugsnag.ISO8601),
+			tuiView.OrBlank(revision),
+			strconv.Itoa(r.TotalSessionsCount),
+			tuiView.Percent(r.CrashFreeSessions()),
+			tuiView.Percent(r.CrashFreeUsers()),
+		})
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, releases, &table))
+	if out.IsHuman() {
+		tuiView.Footer(os.Stderr, len(releases), total, "releases")
+	}
+}
@@ -0,0 +1,38 @@
+package releases
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/releases/diff"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/releases/list"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/releases/view"
+)
+
+const helpText = `Releases shows releases of the configured project with their session
+counts and stability. See available commands below.`
+
+// NewCmdReleases is a releases command.
+func NewCmdReleases() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "releases",
+		Short:       "Releases shows project releases",
+		Long:        helpText,
+		Aliases:     []string{"release"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        releases,
+	}
+
+	cmd.PersistentFlags().String("stage", "", "Only releases in this release stage, eg: production")
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		view.NewCmdView(),
+		diff.NewCmdDiff(),
+	)
+
+	return &cmd
+}
+
+func releases(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
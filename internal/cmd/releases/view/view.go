@@ -0,0 +1,90 @@
+package view
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	tuiView "github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const examples = `# View release by its id
+$ bugsnag releases view 63c1d2e3f4a5b6c7d8e9f0a1
+
+# View the latest production release of an app version
+$ bugsnag releases view 2.4.1 --stage production`
+
+// NewCmdView is a view command.
+func NewCmdView() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "view RELEASE",
+		Short:   "View displays details of a release",
+		Long:    "View displays details of a release including its source control revision and stability.",
+		Example: examples,
+		Aliases: []string{"show"},
+		Annotations: map[string]string{
+			"help:args": "RELEASE\tRelease id or app version",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  view,
+	}
+
+	return &cmd
+}
+
+func view(cmd *cobra.Command, args []string) {
+	stage, err := cmd.Flags().GetString("stage")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	release, err := func() (*bugsnag.Release, error) {
+		s := cmdutil.Info("Fetching release details...")
+		defer s.Stop()
+
+		client := api.DefaultClient(viper.GetBool("debug"))
+
+		projectID, err := cmdutil.ResolveProjectID(client, "")
+		if err != nil {
+			return nil, err
+		}
+		return cmdutil.ResolveRelease(client, projectID, stage, args[0])
+	}()
+	cmdutil.ExitIfError(err)
+
+	details := tuiView.Details{
+		{Label: "ID", Value: release.ID},
+		{Label: "Version", Value: release.AppVersion},
+		{Label: "Bundle version", Value: release.AppBundleVersion},
+		{Label: "Stage", Value: release.ReleaseStage},
+		{Label: "Released", Value: cmdutil.FormatDateTimeHuman(release.ReleaseTime, bugsnag.ISO8601)},
+		{Label: "Source", Value: release.ReleaseSource},
+		{Label: "Builder", Value: release.BuilderName},
+	}
+	if sc := release.SourceControl; sc != nil {
+		details = append(details,
+			tuiView.Field{Label: "Repository", Value: sc.Repository},
+			tuiView.Field{Label: "Revision", Value: sc.Revision},
+			tuiView.Field{Label: "Diff", Value: sc.DiffURLToPrevious},
+		)
+	}
+	details = append(details,
+		tuiView.Field{Label: "Errors introduced", Value: strconv.Itoa(release.ErrorsIntroducedCount)},
+		tuiView.Field{Label: "Errors seen", Value: strconv.Itoa(release.ErrorsSeenCount)},
+		tuiView.Field{Label: "Sessions", Value: strconv.Itoa(release.TotalSessionsCount)},
+		tuiView.Field{Label: "Sessions (24h)", Value: strconv.Itoa(release.SessionsCountInLast24h)},
+		tuiView.Field{Label: "Unhandled sessions", Value: strconv.Itoa(release.UnhandledSessionsCount)},
+		tuiView.Field{Label: "Users", Value: strconv.Itoa(release.AccumulativeDailyUsersSeen)},
+		tuiView.Field{Label: "Crash-free sessions", Value: tuiView.Percent(release.CrashFreeSessions())},
+		tuiView.Field{Label: "Crash-free users", Value: tuiView.Percent(release.CrashFreeUsers())},
+	)
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, release, details))
+}
@@ -9,11 +9,27 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	apiCmd "github.com/teamupstart/bugsnag-data-cli/internal/cmd/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/builds"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/collaborators"
+	errorsCmd "github.com/teamupstart/bugsnag-data-cli/internal/cmd/errors"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/events"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/fields"
 	initCmd "github.com/teamupstart/bugsnag-data-cli/internal/cmd/init"
 	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/me"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/notify"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/orgs"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/pivots"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/projects"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/releases"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/sessions"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/stability"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/trends"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/upload"
 	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/version"
 	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
 	bugsnagConfig "github.com/teamupstart/bugsnag-data-cli/internal/config"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
 
 	"github.com/zalando/go-keyring"
 )
@@ -63,8 +79,7 @@ func NewCmdRoot() *cobra.Command {
 			return cmd.Help()
 		},
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
-			subCmd := cmd.Name()
-			if !cmdRequireToken(subCmd) {
+			if !cmdRequireToken(cmd) {
 				return
 			}
 
@@ -93,6 +108,18 @@ func NewCmdRoot() *cobra.Command {
 			configHome, bugsnagConfig.Dir, bugsnagConfig.FileName,
 		),
 	)
+	cmd.PersistentFlags().StringP(
+		"output", "o", string(output.FormatTable),
+		"Output format: table, json, yaml, csv, tsv, plain, markdown or template=<go-template>",
+	)
+	cmd.PersistentFlags().String(
+		"columns", "",
+		"Comma separated list of columns to show in table, csv, tsv, plain and markdown output",
+	)
+	cmd.PersistentFlags().String(
+		"jq", "",
+		"Filter the response using a jq expression, eg: '.[] | .id'",
+	)
 	cmd.PersistentFlags().BoolVar(&debug, "debug", false, "Turn on debug output")
 
 	cmd.SetHelpFunc(helpFunc)
@@ -109,12 +136,32 @@ func NewCmdRoot() *cobra.Command {
 func addChildCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		initCmd.NewCmdInit(),
+		errorsCmd.NewCmdErrors(),
+		events.NewCmdEvents(),
 		me.NewCmdMe(),
+		orgs.NewCmdOrgs(),
+		projects.NewCmdProjects(),
+		trends.NewCmdTrends(),
+		pivots.NewCmdPivots(),
+		fields.NewCmdFields(),
+		releases.NewCmdReleases(),
+		stability.NewCmdStability(),
+		builds.NewCmdBuilds(),
+		upload.NewCmdUpload(),
+		notify.NewCmdNotify(),
+		sessions.NewCmdSessions(),
+		collaborators.NewCmdCollaborators(),
 		version.NewCmdVersion(),
+		apiCmd.NewCmdAPI(),
 	)
 }
 
-func cmdRequireToken(cmd string) bool {
+func cmdRequireToken(cmd *cobra.Command) bool {
+	// Commands sending data to bugsnag authenticate with a project api key instead.
+	if _, ok := cmd.Annotations["cmd:no-token"]; ok {
+		return false
+	}
+
 	allowList := []string{
 		"init",
 		"help",
@@ -123,7 +170,7 @@ func cmdRequireToken(cmd string) bool {
 	}
 
 	for _, item := range allowList {
-		if item == cmd {
+		if item == cmd.Name() {
 			return false
 		}
 	}
@@ -0,0 +1,184 @@
+package send
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/query"
+	"github.com/teamupstart/bugsnag-data-cli/internal/version"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	helpText = `Send reports aggregated session counts of an app version to the bugsnag
+session tracking api, eg: to backfill sessions lost during an outage or to run
+synthetic checks against stability scores.
+
+Sessions are counted per minute. Send a single count with --count, started now
+or at --started-at, or backfill several minutes with --counts TIME=COUNT.
+Times are truncated to the minute and may be relative, eg: 2h, or absolute,
+eg: 2022-07-12T10:04.
+
+Sessions are authenticated with the notifier api key of the project, which can
+also be set with the BUGSNAG_API_KEY env variable. No api token is needed.`
+
+	examples = `# Report a session started now
+$ bugsnag sessions send --app-version 2.4.1
+
+# Report 120 sessions started an hour ago in staging
+$ bugsnag sessions send --app-version 2.4.1 --release-stage staging --count 120 --started-at 1h
+
+# Backfill sessions of several minutes
+$ bugsnag sessions send --app-version 2.4.1 --counts 2022-07-12T10:00=40,2022-07-12T10:01=38 \
+    --counts 2022-07-12T10:02=51`
+
+	defaultReleaseStage = "production"
+)
+
+// NewCmdSend is a sessions send command.
+func NewCmdSend() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "send",
+		Short:       "Send reports session counts to bugsnag",
+		Long:        helpText,
+		Example:     examples,
+		Annotations: map[string]string{"cmd:no-token": "true"},
+		Args:        cobra.NoArgs,
+		Run:         send,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("api-key", "", "Notifier api key of the project (default $BUGSNAG_API_KEY)")
+	cmd.Flags().String("app-version", "", "Version of the app the sessions were started in")
+	cmd.Flags().String("release-stage", defaultReleaseStage, "Release stage of the app")
+	cmd.Flags().Int("count", 1, "Number of sessions started")
+	cmd.Flags().String("started-at", "", "Time the sessions were started at, eg: 2h, 2022-07-12T10:04 (default now)")
+	cmd.Flags().StringToInt("counts", nil, "Sessions started per minute as TIME=COUNT, can be repeated")
+	cmd.Flags().String(
+		"endpoint", "",
+		"Session tracking api endpoint (default $BUGSNAG_SESSIONS_ENDPOINT or "+bugsnag.DefaultSessionsEndpoint+")",
+	)
+
+	_ = cmd.MarkFlagRequired("app-version")
+
+	return &cmd
+}
+
+func send(cmd *cobra.Command, _ []string) {
+	flags := cmd.Flags()
+
+	apiKey, err := flags.GetString("api-key")
+	cmdutil.ExitIfError(err)
+	if apiKey == "" {
+		apiKey = viper.GetString("api_key")
+	}
+	if apiKey == "" {
+		cmdutil.Failed("No api key specified, please pass one with --api-key or set BUGSNAG_API_KEY.")
+	}
+
+	endpoint, err := flags.GetString("endpoint")
+	cmdutil.ExitIfError(err)
+
+	payload, err := sessionsPayload(flags, time.Now())
+	cmdutil.ExitIfError(err)
+
+	err = func() error {
+		s := cmdutil.Info("Sending sessions...")
+		defer s.Stop()
+
+		client := api.NotifierClient(bugsnag.Config{SessionsEndpoint: endpoint, Debug: viper.GetBool("debug")})
+
+		return client.SendSessions(apiKey, payload)
+	}()
+	cmdutil.ExitIfError(err)
+
+	total := 0
+	for _, c := range payload.SessionCounts {
+		total += c.SessionsStarted
+	}
+	cmdutil.Success("%d sessions of %s sent to bugsnag", total, payload.App.Version)
+}
+
+func sessionsPayload(flags *pflag.FlagSet, now time.Time) (*bugsnag.SessionsPayload, error) {
+	var (
+		app bugsnag.NotifyApp
+		err error
+	)
+	if app.Version, err = flags.GetString("app-version"); err != nil {
+		return nil, err
+	}
+	if app.ReleaseStage, err = flags.GetString("release-stage"); err != nil {
+		return nil, err
+	}
+
+	counts, err := sessionCounts(flags, now)
+	if err != nil {
+		return nil, err
+	}
+
+	p := bugsnag.SessionsPayload{
+		Notifier:      bugsnag.DefaultNotifier(version.Version),
+		App:           &app,
+		SessionCounts: counts,
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// sessionCounts returns counts given with --counts, or a single count from
+// --count and --started-at, sorted by time.
+func sessionCounts(flags *pflag.FlagSet, now time.Time) ([]*bugsnag.SessionCount, error) {
+	pairs, err := flags.GetStringToInt("counts")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pairs) == 0 {
+		count, err := flags.GetInt("count")
+		if err != nil {
+			return nil, err
+		}
+		startedAt, err := flags.GetString("started-at")
+		if err != nil {
+			return nil, err
+		}
+
+		t := now
+		if startedAt != "" {
+			if t, err = query.ParseTime(startedAt, now); err != nil {
+				return nil, err
+			}
+		}
+		return []*bugsnag.SessionCount{bugsnag.NewSessionCount(t, count)}, nil
+	}
+
+	for _, name := range []string{"count", "started-at"} {
+		if flags.Changed(name) {
+			return nil, fmt.Errorf("--counts cannot be used together with --%s", name)
+		}
+	}
+
+	counts := make([]*bugsnag.SessionCount, 0, len(pairs))
+	for s, n := range pairs {
+		t, err := query.ParseTime(s, now)
+		if err != nil {
+			return nil, err
+		}
+		counts = append(counts, bugsnag.NewSessionCount(t, n))
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].StartedAt < counts[j].StartedAt
+	})
+
+	return counts, nil
+}
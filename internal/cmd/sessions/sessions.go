@@ -0,0 +1,31 @@
+package sessions
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/sessions/send"
+)
+
+const helpText = `Sessions reports sessions to the bugsnag session tracking api. See available commands below.`
+
+// NewCmdSessions is a sessions command.
+func NewCmdSessions() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "sessions",
+		Short:       "Sessions reports sessions used for stability scores",
+		Long:        helpText,
+		Aliases:     []string{"session"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        sessions,
+	}
+
+	cmd.AddCommand(
+		send.NewCmdSend(),
+	)
+
+	return &cmd
+}
+
+func sessions(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
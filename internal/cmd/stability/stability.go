@@ -0,0 +1,84 @@
+package stability
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	defaultStage = "production"
+
+	helpText = `Stability shows the daily stability of the configured project against its
+stability target.
+
+Stability is the share of users, or sessions, depending on the project's
+target type, that did not run into an unhandled error.`
+
+	examples = `# Production stability of the configured project
+$ bugsnag stability
+
+# Staging stability as json
+$ bugsnag stability --stage staging -o json`
+)
+
+// NewCmdStability is a stability command.
+func NewCmdStability() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "stability",
+		Short:       "Stability shows project stability against its target",
+		Long:        helpText,
+		Example:     examples,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Args:        cobra.NoArgs,
+		Run:         stability,
+	}
+
+	cmd.Flags().String("stage", defaultStage, "Release stage to show the stability of")
+
+	return &cmd
+}
+
+func stability(cmd *cobra.Command, _ []string) {
+	stage, err := cmd.Flags().GetString("stage")
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	project, trend, err := func() (*bugsnag.Project, *bugsnag.StabilityTrend, error) {
+		s := cmdutil.Info("Fetching stability trend...")
+		defer s.Stop()
+
+		client := api.DefaultClient(viper.GetBool("debug"))
+
+		project, err := cmdutil.ResolveProject(client, "", "")
+		if err != nil {
+			return nil, nil, err
+		}
+		trend, err := client.GetStabilityTrend(project.ID, stage)
+
+		return project, trend, err
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(trend.TimelinePoints) == 0 && out.IsHuman() {
+		fmt.Println()
+		cmdutil.Failed("No sessions found in %s.", stage)
+		return
+	}
+	if trend.ReleaseStageName == "" {
+		trend.ReleaseStageName = stage
+	}
+
+	v := view.Stability{Trend: trend, Project: project}
+	cmdutil.ExitIfError(out.Print(os.Stdout, v.Data(), v))
+}
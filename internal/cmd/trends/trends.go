@@ -0,0 +1,143 @@
+package trends
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	"github.com/teamupstart/bugsnag-data-cli/internal/query"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	defaultBuckets = 24
+
+	helpText = `Trends shows the number of events over time for an error, or for the whole
+project if no error is given.
+
+Events are grouped either in a number of equal buckets with --buckets, or in
+buckets of a fixed size with --resolution.`
+
+	examples = `# Hourly events of an error in the last two days as a bar chart
+$ bugsnag trends --error 61a1b2c3d4e5f6a7b8c9d0e1 --resolution 1h --since 2d --chart bars
+
+# Daily production events of the project as a sparkline
+$ bugsnag trends --resolution 1d --since 30d --filter stage=production --chart sparkline
+
+# Export the trend as csv
+$ bugsnag trends --buckets 50 --since 7d -o csv`
+)
+
+var resolutions = []string{"1h", "1d"}
+
+// NewCmdTrends is a trends command.
+func NewCmdTrends() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "trends",
+		Short:       "Trends shows events over time",
+		Long:        helpText,
+		Example:     examples,
+		Aliases:     []string{"trend"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		Run:         trends,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringP("error", "e", "", "Show the trend of this error only")
+	cmd.Flags().String("resolution", "", "Size of each bucket, one of: 1h, 1d")
+	cmd.Flags().Int("buckets", 0, fmt.Sprintf("Number of buckets, at most %d (default %d)", bugsnag.MaxTrendBuckets, defaultBuckets))
+	cmd.Flags().String("since", "", "Only events received after this time, eg: 2022-07-01, 2022-07-01T15:04:05Z or 7d")
+	cmd.Flags().String("until", "", "Only events received before this time, same formats as --since")
+	cmd.Flags().StringToStringP("filter", "f", nil, query.FilterHelp("events"))
+	_ = cmd.RegisterFlagCompletionFunc("filter", cmdutil.CompleteFilter)
+	cmd.Flags().String("chart", view.ChartNone, "Draw the trend as a chart, one of: bars, sparkline")
+
+	return &cmd
+}
+
+func trends(cmd *cobra.Command, _ []string) {
+	flags := cmd.Flags()
+
+	errorID, err := flags.GetString("error")
+	cmdutil.ExitIfError(err)
+
+	opts, err := trendOptions(flags)
+	cmdutil.ExitIfError(err)
+
+	chart, err := flags.GetString("chart")
+	cmdutil.ExitIfError(err)
+	switch chart {
+	case view.ChartNone, view.ChartBars, view.ChartSparkline:
+	default:
+		cmdutil.Failed("Invalid chart %q. Must be one of: bars, sparkline.", chart)
+	}
+
+	filters, err := query.Filters(flags)
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(query.TimeRange(flags, filters, time.Now()))
+
+	out, err := output.FromFlags(flags)
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(viper.GetBool("debug"))
+
+	projectID, err := cmdutil.ResolveProjectID(client, "")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cmdutil.ValidateFilters(client, projectID, filters))
+
+	buckets, err := func() ([]*bugsnag.TrendBucket, error) {
+		s := cmdutil.Info("Fetching trend...")
+		defer s.Stop()
+
+		return client.GetTrend(projectID, errorID, filters, opts)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(buckets) == 0 && out.IsHuman() {
+		fmt.Println()
+		cmdutil.Failed("No events found.")
+		return
+	}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, buckets, view.Trend{Buckets: buckets, Chart: chart}))
+}
+
+func trendOptions(flags query.FlagParser) (*bugsnag.TrendOptions, error) {
+	resolution, err := flags.GetString("resolution")
+	if err != nil {
+		return nil, err
+	}
+
+	buckets, err := flags.GetInt("buckets")
+	if err != nil {
+		return nil, err
+	}
+
+	if resolution != "" && buckets > 0 {
+		return nil, fmt.Errorf("--resolution and --buckets cannot be used together")
+	}
+	if resolution != "" {
+		for _, r := range resolutions {
+			if r == resolution {
+				return &bugsnag.TrendOptions{Resolution: resolution}, nil
+			}
+		}
+		return nil, fmt.Errorf("invalid resolution %q, must be one of: 1h, 1d", resolution)
+	}
+
+	if buckets < 0 || buckets > bugsnag.MaxTrendBuckets {
+		return nil, fmt.Errorf("--buckets must be between 1 and %d", bugsnag.MaxTrendBuckets)
+	}
+	if buckets == 0 {
+		buckets = defaultBuckets
+	}
+	return &bugsnag.TrendOptions{BucketsCount: buckets}, nil
+}
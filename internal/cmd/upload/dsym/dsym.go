@@ -0,0 +1,74 @@
+package dsym
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag/upload"
+)
+
+const (
+	helpText = `Dsym uploads ios and macos debug symbols.
+
+Paths may be dSYM bundles, directories containing them, or zipped bundles.
+The DWARF files of each bundle are uploaded separately.`
+
+	examples = `# Upload symbols of an archive
+$ bugsnag upload dsym build/App.xcarchive/dSYMs
+
+# Upload symbols downloaded from app store connect
+$ bugsnag upload dsym appDsyms.zip --overwrite`
+)
+
+// NewCmdDSYM is a dsym command.
+func NewCmdDSYM() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "dsym PATH...",
+		Short:       "Dsym uploads ios and macos debug symbols",
+		Long:        helpText,
+		Example:     examples,
+		Aliases:     []string{"dsyms", "ios"},
+		Annotations: map[string]string{"cmd:no-token": "true", "help:args": "PATH\tdSYM bundle, zip archive, directory or glob pattern"},
+		Args:        cobra.MinimumNArgs(1),
+		Run:         dsym,
+	}
+
+	return &cmd
+}
+
+func dsym(cmd *cobra.Command, args []string) {
+	flags := cmd.Flags()
+
+	opts, err := cmdutil.UploadOptionsFromFlags(flags)
+	cmdutil.ExitIfError(err)
+
+	out, err := output.FromFlags(flags)
+	cmdutil.ExitIfError(err)
+
+	files, err := upload.FindDSYMs(args)
+	cmdutil.ExitIfError(err)
+	if len(files) == 0 {
+		cmdutil.Failed("No dSYM files found.")
+	}
+
+	requests := make([]upload.Request, 0, len(files))
+	for _, f := range files {
+		requests = append(requests, &upload.DSYM{APIKey: opts.APIKey, File: f, Overwrite: opts.Overwrite})
+	}
+
+	var errs []error
+	if !opts.DryRun {
+		errs = cmdutil.Upload(opts, requests)
+	}
+
+	results := view.NewUploadResults(files, nil, errs)
+	cmdutil.ExitIfError(out.Print(os.Stdout, results, results))
+
+	if n := results.Failed(); n > 0 {
+		cmdutil.Failed("%d of %d dSYM files failed to upload.", n, len(files))
+	}
+}
@@ -0,0 +1,101 @@
+package proguard
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag/upload"
+)
+
+const (
+	helpText = `Proguard uploads android proguard or r8 mapping files.
+
+Directories are searched for mapping.txt files. Mappings are matched to
+builds by app id and either both the version name and code, or a build uuid.`
+
+	examples = `# Upload the mapping of a release build
+$ bugsnag upload proguard app/build/outputs/mapping/release/mapping.txt \
+    --app-id com.example.app --version-name 2.4.1 --version-code 42
+
+# Upload mappings of all flavors
+$ bugsnag upload proguard app/build/outputs/mapping --app-id com.example.app --build-uuid $BUILD_UUID`
+
+	mappingPattern = "mapping.txt"
+)
+
+// NewCmdProGuard is a proguard command.
+func NewCmdProGuard() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "proguard PATH...",
+		Short:       "Proguard uploads android mapping files",
+		Long:        helpText,
+		Example:     examples,
+		Aliases:     []string{"r8", "android"},
+		Annotations: map[string]string{"cmd:no-token": "true", "help:args": "PATH\tMapping file, directory or glob pattern"},
+		Args:        cobra.MinimumNArgs(1),
+		Run:         proGuard,
+	}
+
+	cmd.Flags().String("app-id", "", "Application id of the app, eg: com.example.app")
+	cmd.Flags().String("version-name", "", "Version name of the build")
+	cmd.Flags().String("version-code", "", "Version code of the build")
+	cmd.Flags().String("build-uuid", "", "Unique id of the build, if version name and code are not enough")
+
+	_ = cmd.MarkFlagRequired("app-id")
+
+	return &cmd
+}
+
+func proGuard(cmd *cobra.Command, args []string) {
+	flags := cmd.Flags()
+
+	opts, err := cmdutil.UploadOptionsFromFlags(flags)
+	cmdutil.ExitIfError(err)
+
+	base := upload.ProGuard{APIKey: opts.APIKey, Overwrite: opts.Overwrite}
+	for name, dst := range map[string]*string{
+		"app-id":       &base.AppID,
+		"version-name": &base.VersionName,
+		"version-code": &base.VersionCode,
+		"build-uuid":   &base.BuildUUID,
+	} {
+		*dst, err = flags.GetString(name)
+		cmdutil.ExitIfError(err)
+	}
+
+	out, err := output.FromFlags(flags)
+	cmdutil.ExitIfError(err)
+
+	files, err := upload.Find(args, mappingPattern)
+	cmdutil.ExitIfError(err)
+	if len(files) == 0 {
+		cmdutil.Failed("No mapping files found.")
+	}
+
+	requests := make([]upload.Request, 0, len(files))
+	for _, f := range files {
+		r := base
+		r.File = f
+
+		_, err := r.Form()
+		cmdutil.ExitIfError(err)
+
+		requests = append(requests, &r)
+	}
+
+	var errs []error
+	if !opts.DryRun {
+		errs = cmdutil.Upload(opts, requests)
+	}
+
+	results := view.NewUploadResults(files, nil, errs)
+	cmdutil.ExitIfError(out.Print(os.Stdout, results, results))
+
+	if n := results.Failed(); n > 0 {
+		cmdutil.Failed("%d of %d mapping files failed to upload.", n, len(files))
+	}
+}
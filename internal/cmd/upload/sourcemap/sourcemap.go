@@ -0,0 +1,144 @@
+package sourcemap
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag/upload"
+)
+
+const (
+	helpText = `Sourcemap uploads javascript source maps.
+
+Directories are searched for *.map files. The url of each minified file is
+its path relative to --base-dir joined to --base-url, and the minified file
+itself is uploaded along with the map if it sits next to it, eg: with
+--base-url https://example.com/static, dist/js/app.js.map is uploaded for
+https://example.com/static/js/app.js.
+
+--base-dir defaults to the first path if it is a directory, and to the
+working directory otherwise.`
+
+	examples = `# Upload all source maps of a build
+$ bugsnag upload sourcemap dist --base-url https://example.com/static --app-version 2.4.1
+
+# Upload a single map for any host
+$ bugsnag upload sourcemap dist/app.js.map --base-dir dist --base-url '*' --overwrite
+
+# Check which urls maps would be uploaded for
+$ bugsnag upload sourcemap 'dist/js/*.map' --base-dir dist --base-url https://example.com --dry-run`
+
+	mapPattern = "*.map"
+)
+
+// NewCmdSourceMap is a sourcemap command.
+func NewCmdSourceMap() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "sourcemap PATH...",
+		Short:       "Sourcemap uploads javascript source maps",
+		Long:        helpText,
+		Example:     examples,
+		Aliases:     []string{"sourcemaps", "js"},
+		Annotations: map[string]string{"cmd:no-token": "true", "help:args": "PATH\tSource map, directory or glob pattern"},
+		Args:        cobra.MinimumNArgs(1),
+		Run:         sourceMap,
+	}
+
+	cmd.Flags().String("app-version", "", "Version of the app the source maps belong to")
+	cmd.Flags().String("base-url", "", "Url the minified files are served from, may contain a * wildcard")
+	cmd.Flags().String("base-dir", "", "Directory minified file paths are relative to")
+
+	_ = cmd.MarkFlagRequired("base-url")
+
+	return &cmd
+}
+
+func sourceMap(cmd *cobra.Command, args []string) {
+	flags := cmd.Flags()
+
+	opts, err := cmdutil.UploadOptionsFromFlags(flags)
+	cmdutil.ExitIfError(err)
+
+	appVersion, err := flags.GetString("app-version")
+	cmdutil.ExitIfError(err)
+
+	baseURL, err := flags.GetString("base-url")
+	cmdutil.ExitIfError(err)
+
+	baseDir, err := flags.GetString("base-dir")
+	cmdutil.ExitIfError(err)
+	if baseDir == "" {
+		baseDir = defaultBaseDir(args[0])
+	}
+
+	out, err := output.FromFlags(flags)
+	cmdutil.ExitIfError(err)
+
+	files, err := upload.Find(args, mapPattern)
+	cmdutil.ExitIfError(err)
+	if len(files) == 0 {
+		cmdutil.Failed("No source maps found.")
+	}
+
+	var (
+		requests = make([]upload.Request, 0, len(files))
+		urls     = make([]string, 0, len(files))
+	)
+	for _, f := range files {
+		minified := strings.TrimSuffix(f, ".map")
+
+		u, err := minifiedURL(baseURL, baseDir, minified)
+		cmdutil.ExitIfError(err)
+
+		r := upload.SourceMap{
+			APIKey:      opts.APIKey,
+			AppVersion:  appVersion,
+			MinifiedURL: u,
+			SourceMap:   f,
+			Overwrite:   opts.Overwrite,
+		}
+		if _, err := os.Stat(minified); err == nil && minified != f {
+			r.MinifiedFile = minified
+		}
+
+		requests = append(requests, &r)
+		urls = append(urls, u)
+	}
+
+	var errs []error
+	if !opts.DryRun {
+		errs = cmdutil.Upload(opts, requests)
+	}
+
+	results := view.NewUploadResults(files, urls, errs)
+	cmdutil.ExitIfError(out.Print(os.Stdout, results, results))
+
+	if n := results.Failed(); n > 0 {
+		cmdutil.Failed("%d of %d source maps failed to upload.", n, len(files))
+	}
+}
+
+func defaultBaseDir(p string) string {
+	if info, err := os.Stat(p); err == nil && info.IsDir() {
+		return p
+	}
+	return "."
+}
+
+// minifiedURL joins path of the minified file relative to baseDir to baseURL.
+func minifiedURL(baseURL, baseDir, file string) (string, error) {
+	rel, err := filepath.Rel(baseDir, file)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("%s is not inside base directory %s", file, baseDir)
+	}
+
+	return strings.TrimSuffix(baseURL, "/") + "/" + path.Clean(filepath.ToSlash(rel)), nil
+}
@@ -0,0 +1,51 @@
+package upload
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/upload/dsym"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/upload/proguard"
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmd/upload/sourcemap"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag/upload"
+)
+
+const (
+	defaultConcurrency = 4
+
+	helpText = `Upload uploads source maps and symbol files so that stacktraces of minified
+or compiled apps are readable in bugsnag. See available commands below.
+
+Uploads are authenticated with the notifier api key of the project, which can
+also be set with the BUGSNAG_API_KEY env variable. No api token is needed.`
+)
+
+// NewCmdUpload is an upload command.
+func NewCmdUpload() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "upload",
+		Short:       "Upload uploads source maps and symbol files",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        uploads,
+	}
+
+	cmd.PersistentFlags().String("api-key", "", "Notifier api key of the project (default $BUGSNAG_API_KEY)")
+	cmd.PersistentFlags().String(
+		"endpoint", "", "Upload api endpoint (default $BUGSNAG_UPLOAD_ENDPOINT or "+upload.DefaultEndpoint+")",
+	)
+	cmd.PersistentFlags().Int("concurrency", defaultConcurrency, "Maximum number of parallel uploads")
+	cmd.PersistentFlags().Bool("overwrite", false, "Replace files previously uploaded for the same version")
+	cmd.PersistentFlags().Bool("dry-run", false, "List files that would be uploaded without uploading them")
+
+	cmd.AddCommand(
+		sourcemap.NewCmdSourceMap(),
+		proguard.NewCmdProGuard(),
+		dsym.NewCmdDSYM(),
+	)
+
+	return &cmd
+}
+
+func uploads(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -1,11 +1,14 @@
 package version
 
 import (
-	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/output"
 	v "github.com/teamupstart/bugsnag-data-cli/internal/version"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
 )
 
 // NewCmdVersion is a version command.
@@ -18,6 +21,18 @@ func NewCmdVersion() *cobra.Command {
 	}
 }
 
-func version(*cobra.Command, []string) {
-	fmt.Println(v.Info())
+func version(cmd *cobra.Command, _ []string) {
+	out, err := output.FromFlags(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	data := struct {
+		Version    string `json:"version"`
+		GitCommit  string `json:"git_commit"`
+		CommitDate string `json:"commit_date"`
+		GoVersion  string `json:"go_version"`
+		Compiler   string `json:"compiler"`
+		Platform   string `json:"platform"`
+	}{v.Version, v.GitCommit, v.CommitDate(), v.GoVersion, v.Compiler, v.Platform}
+
+	cmdutil.ExitIfError(out.Print(os.Stdout, data, view.Value{Name: "VERSION", Value: v.Info()}))
 }
@@ -0,0 +1,159 @@
+package cmdutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/query"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const (
+	fieldsCacheTTL  = 24 * time.Hour
+	cacheDir        = "bugsnag"
+	cacheDirPerm    = 0o700
+	cacheFilePerm   = 0o600
+	fieldsCacheName = "event-fields-%s.json"
+)
+
+type fieldsCache struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Fields    []string  `json:"fields"`
+}
+
+// EventFields returns ids of filterable event fields of the project. Fields are
+// cached for a day and fetched from the api if the cache is stale or refresh is set.
+func EventFields(client *bugsnag.Client, projectID string, refresh bool) ([]string, error) {
+	if !refresh {
+		if c, err := readFieldsCache(projectID); err == nil && time.Since(c.FetchedAt) < fieldsCacheTTL {
+			return c.Fields, nil
+		}
+	}
+
+	fields, err := client.ListEventFields(projectID)
+	if err != nil {
+		return nil, err
+	}
+	return CacheEventFields(projectID, fields), nil
+}
+
+// CacheEventFields saves ids of the fields to the cache and returns them. Failing
+// to write the cache is not an error as it only saves api calls.
+func CacheEventFields(projectID string, fields []*bugsnag.EventField) []string {
+	ids := make([]string, 0, len(fields))
+	for _, f := range fields {
+		ids = append(ids, f.DisplayID)
+	}
+	sort.Strings(ids)
+
+	if path, err := fieldsCachePath(projectID); err == nil {
+		if b, err := json.Marshal(fieldsCache{FetchedAt: time.Now(), Fields: ids}); err == nil {
+			if err := os.MkdirAll(filepath.Dir(path), cacheDirPerm); err == nil {
+				_ = os.WriteFile(path, b, cacheFilePerm)
+			}
+		}
+	}
+
+	return ids
+}
+
+// ValidateFilters checks filter keys against event fields of the project so that
+// typos fail instead of silently matching every event. Cached fields are refreshed
+// once before a key is reported as unknown, in case the field was added recently.
+// If fields cannot be fetched, filters are sent as is with a warning.
+func ValidateFilters(client *bugsnag.Client, projectID string, filters bugsnag.Filters) error {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	fields, err := EventFields(client, projectID, false)
+	if err != nil {
+		warnUnvalidatedFilters(err)
+		return nil
+	}
+	if query.ValidateFields(filters, fields) == nil {
+		return nil
+	}
+
+	if fields, err = EventFields(client, projectID, true); err != nil {
+		warnUnvalidatedFilters(err)
+		return nil
+	}
+	return query.ValidateFields(filters, fields)
+}
+
+func warnUnvalidatedFilters(err error) {
+	reason := strings.TrimSpace(err.Error())
+
+	var unexpected *bugsnag.ErrUnexpectedResponse
+	if errors.As(err, &unexpected) {
+		reason = unexpected.Status
+	}
+	Warn("Couldn't fetch event fields to validate filters, sending them as is: %s", reason)
+}
+
+// CompleteFilter completes keys of the --filter flag with event fields of the configured project.
+func CompleteFilter(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if strings.Contains(toComplete, "=") {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	client := api.DefaultClient(false)
+
+	projectID, err := ResolveProjectID(client, "")
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	fields, err := EventFields(client, projectID, false)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	for k := range query.FilterAliases {
+		fields = append(fields, k)
+	}
+
+	var out []string
+	for _, f := range fields {
+		if strings.HasPrefix(f, toComplete) {
+			out = append(out, f+"=")
+		}
+	}
+	sort.Strings(out)
+
+	return out, cobra.ShellCompDirectiveNoSpace | cobra.ShellCompDirectiveNoFileComp
+}
+
+func readFieldsCache(projectID string) (*fieldsCache, error) {
+	path, err := fieldsCachePath(projectID)
+	if err != nil {
+		return nil, err
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c fieldsCache
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func fieldsCachePath(projectID string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, cacheDir, fmt.Sprintf(fieldsCacheName, filepath.Base(projectID))), nil
+}
@@ -0,0 +1,49 @@
+package cmdutil
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strings"
+)
+
+// GitRevision returns the commit checked out in the git repository containing dir.
+func GitRevision(dir string) (string, error) {
+	return git(dir, "rev-parse", "HEAD")
+}
+
+// GitRemoteURL returns url of the named remote of the git repository containing dir.
+// Credentials embedded in the url are removed so that they are never sent anywhere.
+func GitRemoteURL(dir, remote string) (string, error) {
+	raw, err := git(dir, "remote", "get-url", remote)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		// Scp-like urls, eg: git@github.com:org/repo.git, carry no secrets.
+		return raw, nil
+	}
+	u.User = nil
+
+	return u.String(), nil
+}
+
+func git(dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
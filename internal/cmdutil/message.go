@@ -0,0 +1,55 @@
+package cmdutil
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/mattn/go-isatty"
+)
+
+// StdinIsTerminal checks if standard input is attached to a terminal.
+func StdinIsTerminal() bool {
+	fd := os.Stdin.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// ReadMessage reads a message from the body, the body file or piped stdin, in that order.
+// If none is provided and the session is interactive, user's $EDITOR is opened prefilled
+// with the given text.
+func ReadMessage(body, bodyFile, text string) (string, error) {
+	if body != "" && bodyFile != "" {
+		return "", fmt.Errorf("--body and --body-file cannot be used together")
+	}
+
+	msg := body
+	switch {
+	case body != "":
+	case bodyFile != "" || StdinHasData():
+		b, err := ReadFile(bodyFile)
+		if err != nil {
+			return "", err
+		}
+		msg = string(b)
+	case StdinIsTerminal():
+		prompt := &survey.Editor{
+			Message:       "Message",
+			FileName:      "*.md",
+			Default:       text,
+			AppendDefault: true,
+			HideDefault:   true,
+		}
+		if err := survey.AskOne(prompt, &msg); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("message is required, pass it with --body, --body-file or stdin")
+	}
+
+	msg = strings.TrimSpace(msg)
+	if msg == "" {
+		return "", fmt.Errorf("message cannot be empty")
+	}
+	return msg, nil
+}
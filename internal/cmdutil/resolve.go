@@ -0,0 +1,167 @@
+package cmdutil
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+var idRegex = regexp.MustCompile(`^[0-9a-f]{24}$`)
+
+// IsID checks if the given string looks like a bugsnag object id.
+func IsID(s string) bool {
+	return idRegex.MatchString(s)
+}
+
+// ResolveOrganization finds an organization by its id, slug or name. If ref is empty,
+// configured organization is used and, failing that, the only organization user belongs to.
+func ResolveOrganization(client *bugsnag.Client, ref string) (*bugsnag.Organization, error) {
+	if ref == "" {
+		ref = viper.GetString("organization")
+	}
+	if IsID(ref) {
+		return client.GetOrganization(ref)
+	}
+
+	orgs, err := client.ListOrganizations()
+	if err != nil {
+		return nil, err
+	}
+
+	if ref == "" {
+		switch len(orgs) {
+		case 0:
+			return nil, fmt.Errorf("no organizations found for the configured user")
+		case 1:
+			return orgs[0], nil
+		default:
+			return nil, fmt.Errorf(
+				"user belongs to multiple organizations, please set a default one with " +
+					"'bugsnag init --organization' or the organization config key",
+			)
+		}
+	}
+
+	if o := MatchOrganization(orgs, ref); o != nil {
+		return o, nil
+	}
+	return nil, fmt.Errorf("organization %q not found", ref)
+}
+
+// MatchOrganization returns the organization with the given id, slug or name, or nil if there is none.
+func MatchOrganization(orgs []*bugsnag.Organization, ref string) *bugsnag.Organization {
+	for _, o := range orgs {
+		if o.ID == ref || o.Slug == ref || strings.EqualFold(o.Name, ref) {
+			return o
+		}
+	}
+	return nil
+}
+
+// ResolveProject finds a project by its id, slug or name. If ref is empty, configured project
+// is used. Slugs and names are looked up in the organization resolved from orgRef.
+func ResolveProject(client *bugsnag.Client, orgRef, ref string) (*bugsnag.Project, error) {
+	if ref == "" {
+		ref = viper.GetString("project.key")
+	}
+	if ref == "" {
+		return nil, fmt.Errorf("no project specified, please pass one with --project or run 'bugsnag init'")
+	}
+	if IsID(ref) {
+		return client.GetProject(ref)
+	}
+
+	org, err := ResolveOrganization(client, orgRef)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := client.ListProjects(org.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p := MatchProject(projects, ref); p != nil {
+		return p, nil
+	}
+	return nil, fmt.Errorf("project %q not found in organization %q", ref, org.Name)
+}
+
+// MatchProject returns the project with the given id, slug or name, or nil if there is none.
+func MatchProject(projects []*bugsnag.Project, ref string) *bugsnag.Project {
+	for _, p := range projects {
+		if p.ID == ref || p.Slug == ref || strings.EqualFold(p.Name, ref) {
+			return p
+		}
+	}
+	return nil
+}
+
+// ResolveProjectID is like ResolveProject but avoids the api call if ref is already an id.
+func ResolveProjectID(client *bugsnag.Client, ref string) (string, error) {
+	if ref == "" {
+		ref = viper.GetString("project.key")
+	}
+	if IsID(ref) {
+		return ref, nil
+	}
+
+	p, err := ResolveProject(client, "", ref)
+	if err != nil {
+		return "", err
+	}
+	return p.ID, nil
+}
+
+// ResolveCollaborator finds a collaborator of the organization by its id or email.
+// The special ref "me" resolves to the authenticated user.
+func ResolveCollaborator(client *bugsnag.Client, orgID, ref string) (*bugsnag.Collaborator, error) {
+	if strings.EqualFold(ref, "me") {
+		me, err := client.Me()
+		if err != nil {
+			return nil, err
+		}
+		if me.ID != "" {
+			ref = me.ID
+		} else {
+			ref = me.Login
+		}
+	}
+
+	collaborators, err := client.ListCollaborators(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range collaborators {
+		if c.ID == ref || strings.EqualFold(c.Email, ref) {
+			return c, nil
+		}
+	}
+
+	return nil, fmt.Errorf("collaborator %q not found, run 'bugsnag collaborators list' to see available collaborators", ref)
+}
+
+// ResolveRelease finds a release of the project by its id or app version. Versions are
+// looked up in the release stage, or in all stages if stage is empty, newest release first.
+func ResolveRelease(client *bugsnag.Client, projectID, stage, ref string) (*bugsnag.Release, error) {
+	if IsID(ref) {
+		return client.GetRelease(ref)
+	}
+
+	releases, err := client.ListReleases(projectID, stage, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range releases {
+		if r.AppVersion == ref {
+			return r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("release %q not found, run 'bugsnag releases list' to see available releases", ref)
+}
@@ -0,0 +1,99 @@
+package cmdutil
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+
+	"github.com/spf13/viper"
+
+	"github.com/teamupstart/bugsnag-data-cli/api"
+	"github.com/teamupstart/bugsnag-data-cli/internal/query"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag/upload"
+)
+
+// UploadOptions holds flags shared by upload commands.
+type UploadOptions struct {
+	APIKey      string
+	Endpoint    string
+	Concurrency int
+	Overwrite   bool
+	DryRun      bool
+}
+
+// UploadOptionsFromFlags reads upload options from flags, falling back to the
+// BUGSNAG_API_KEY and BUGSNAG_UPLOAD_ENDPOINT env variables.
+func UploadOptionsFromFlags(flags query.FlagParser) (*UploadOptions, error) {
+	var (
+		opts UploadOptions
+		err  error
+	)
+
+	if opts.APIKey, err = flags.GetString("api-key"); err != nil {
+		return nil, err
+	}
+	if opts.Endpoint, err = flags.GetString("endpoint"); err != nil {
+		return nil, err
+	}
+	if opts.Concurrency, err = flags.GetInt("concurrency"); err != nil {
+		return nil, err
+	}
+	if opts.Overwrite, err = flags.GetBool("overwrite"); err != nil {
+		return nil, err
+	}
+	if opts.DryRun, err = flags.GetBool("dry-run"); err != nil {
+		return nil, err
+	}
+
+	if opts.APIKey == "" {
+		opts.APIKey = viper.GetString("api_key")
+	}
+	if opts.APIKey == "" {
+		return nil, fmt.Errorf("no api key specified, please pass one with --api-key or set BUGSNAG_API_KEY")
+	}
+	if opts.Endpoint == "" {
+		opts.Endpoint = viper.GetString("upload_endpoint")
+	}
+	if opts.Concurrency < 1 {
+		return nil, fmt.Errorf("--concurrency must be at least 1")
+	}
+
+	return &opts, nil
+}
+
+// Upload sends upload requests in parallel, showing progress in a spinner.
+// It returns one error per request, nil for successful uploads.
+func Upload(opts *UploadOptions, requests []upload.Request) []error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	s := Info(fmt.Sprintf("Uploading %d files...", len(requests)))
+	defer s.Stop()
+
+	var (
+		mu   sync.Mutex
+		done int
+	)
+
+	// Uploads share transport and retry settings with the api client, but
+	// authenticate with the api key only.
+	client := upload.NewClient(upload.Config{
+		Endpoint:    opts.Endpoint,
+		Transport:   api.Transport(),
+		RetryPolicy: api.RetryPolicy(),
+		Debug:       viper.GetBool("debug"),
+	})
+
+	return client.UploadAll(ctx, requests, opts.Concurrency, func(int, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		done++
+
+		s.Lock()
+		s.Suffix = fmt.Sprintf(" Uploaded %d of %d files...", done, len(requests))
+		s.Unlock()
+	})
+}
@@ -56,14 +56,12 @@ type BugsnagCLIConfigGenerator struct {
 		project      *projectConf
 	}
 	bugsnagClient *bugsnag.Client
-	projectsMap   map[string]*projectConf
 }
 
 // NewBugsnagCLIConfigGenerator creates a new Bugsnag CLI config.
 func NewBugsnagCLIConfigGenerator(cfg *BugsnagCLIConfig) *BugsnagCLIConfigGenerator {
 	gen := BugsnagCLIConfigGenerator{
-		usrCfg:      cfg,
-		projectsMap: make(map[string]*projectConf),
+		usrCfg: cfg,
 	}
 
 	return &gen
@@ -84,6 +82,12 @@ func (c *BugsnagCLIConfigGenerator) Generate() (string, error) {
 	if err := c.configureEndpointAndLoginDetails(); err != nil {
 		return "", err
 	}
+	if err := c.configureOrganization(); err != nil {
+		return "", err
+	}
+	if err := c.configureProject(); err != nil {
+		return "", err
+	}
 
 	home, err := cmdutil.GetConfigHome()
 	if err != nil {
@@ -205,6 +209,96 @@ func (c *BugsnagCLIConfigGenerator) verifyLoginDetails(api_endpoint, login strin
 	return nil
 }
 
+func (c *BugsnagCLIConfigGenerator) configureOrganization() error {
+	orgs, err := func() ([]*bugsnag.Organization, error) {
+		s := cmdutil.Info("Fetching organizations...")
+		defer s.Stop()
+
+		return c.bugsnagClient.ListOrganizations()
+	}()
+	if err != nil {
+		return err
+	}
+	if len(orgs) == 0 {
+		return fmt.Errorf("no organizations found for user %q", c.value.login)
+	}
+
+	if c.usrCfg.Organization != "" {
+		o := cmdutil.MatchOrganization(orgs, c.usrCfg.Organization)
+		if o == nil {
+			return fmt.Errorf("organization %q not found", c.usrCfg.Organization)
+		}
+		c.value.organization = o.ID
+		return nil
+	}
+
+	if len(orgs) == 1 {
+		c.value.organization = orgs[0].ID
+		return nil
+	}
+
+	options := make([]string, 0, len(orgs))
+	for _, o := range orgs {
+		options = append(options, fmt.Sprintf("%s (%s)", o.Name, o.Slug))
+	}
+
+	i, err := askSelect(
+		"Default organization:",
+		"This is your bugsnag organization that the tool will look into by default.",
+		options,
+	)
+	if err != nil {
+		return err
+	}
+
+	c.value.organization = orgs[i].ID
+
+	return nil
+}
+
+func (c *BugsnagCLIConfigGenerator) configureProject() error {
+	projects, err := func() ([]*bugsnag.Project, error) {
+		s := cmdutil.Info("Fetching projects...")
+		defer s.Stop()
+
+		return c.bugsnagClient.ListProjects(c.value.organization)
+	}()
+	if err != nil {
+		return err
+	}
+	if len(projects) == 0 {
+		return fmt.Errorf("no projects found in the selected organization")
+	}
+
+	if c.usrCfg.Project != "" {
+		p := cmdutil.MatchProject(projects, c.usrCfg.Project)
+		if p == nil {
+			return fmt.Errorf("project %q not found in the selected organization", c.usrCfg.Project)
+		}
+		c.value.project = &projectConf{Id: p.ID, Type: p.Type, Name: p.Name}
+		return nil
+	}
+
+	options := make([]string, 0, len(projects))
+	for _, p := range projects {
+		options = append(options, fmt.Sprintf("%s (%s)", p.Name, p.Slug))
+	}
+
+	i, err := askSelect(
+		"Default project:",
+		"This is your bugsnag project that the tool will look into by default. You can override it with the --project flag.",
+		options,
+	)
+	if err != nil {
+		return err
+	}
+
+	p := projects[i]
+	c.value.project = &projectConf{Id: p.ID, Type: p.Type, Name: p.Name}
+
+	return nil
+}
+
 func (c *BugsnagCLIConfigGenerator) write(path string) (string, error) {
 	config := viper.New()
 	config.AddConfigPath(path)
@@ -213,6 +307,13 @@ func (c *BugsnagCLIConfigGenerator) write(path string) (string, error) {
 
 	config.Set("api_endpoint", c.value.api_endpoint)
 	config.Set("login", c.value.login)
+	config.Set("organization", c.value.organization)
+
+	if c.value.project != nil {
+		config.Set("project.key", c.value.project.Id)
+		config.Set("project.name", c.value.project.Name)
+		config.Set("project.type", c.value.project.Type)
+	}
 
 	if err := config.WriteConfig(); err != nil {
 		return "", err
@@ -231,6 +332,23 @@ func Exists(file string) bool {
 	return true
 }
 
+// askSelect prompts to select one of the options and returns its index. Options are
+// selected by position as labels of different organizations or projects may be equal.
+var askSelect = func(message, help string, options []string) (int, error) {
+	var ans int
+
+	prompt := &survey.Select{
+		Message: message,
+		Help:    help,
+		Options: options,
+	}
+	if err := survey.AskOne(prompt, &ans, survey.WithValidator(survey.Required)); err != nil {
+		return 0, err
+	}
+
+	return ans, nil
+}
+
 func shallOverwrite() bool {
 	var ans bool
 
@@ -1,10 +1,14 @@
 package config
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
 )
 
 func TestExists(t *testing.T) {
@@ -67,3 +71,89 @@ func TestCreate(t *testing.T) {
 	assert.NoError(t, os.Remove(path+file+".bkp"))
 	assert.NoError(t, os.Remove(path))
 }
+
+func TestConfigureOrganizationAndProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/user/organizations":
+			// Both organizations have the same name and slug, so only their position tells them apart.
+			_, _ = w.Write([]byte(`[
+				{"id": "o1", "name": "Upstart", "slug": "upstart"},
+				{"id": "o2", "name": "Upstart", "slug": "upstart"},
+				{"id": "o3", "name": "Upstart Labs", "slug": "upstart-labs"}
+			]`))
+		case "/organizations/o2/projects":
+			_, _ = w.Write([]byte(`[
+				{"id": "p1", "name": "Web", "slug": "web", "type": "js"},
+				{"id": "p2", "name": "Web", "slug": "web", "type": "rails"},
+				{"id": "p3", "name": "Mobile App", "slug": "mobile-app", "type": "android"}
+			]`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	defer func(orig func(string, string, []string) (int, error)) { askSelect = orig }(askSelect)
+
+	newGenerator := func(cfg *BugsnagCLIConfig) *BugsnagCLIConfigGenerator {
+		gen := NewBugsnagCLIConfigGenerator(cfg)
+		gen.bugsnagClient = bugsnag.NewClient(bugsnag.Config{APIEndpoint: server.URL, APIToken: "secret"})
+		return gen
+	}
+
+	t.Run("it matches flags by id, slug or name", func(t *testing.T) {
+		askSelect = func(string, string, []string) (int, error) {
+			t.Error("unexpected prompt")
+			return 0, nil
+		}
+
+		for _, tc := range []struct {
+			org, project       string
+			expectedOrg        string
+			expectedProjectKey string
+		}{
+			{org: "o2", project: "p2", expectedOrg: "o2", expectedProjectKey: "p2"},
+			{org: "upstart-labs", expectedOrg: "o3"},
+			{org: "UPSTART LABS", expectedOrg: "o3"},
+			{org: "o2", project: "mobile-app", expectedOrg: "o2", expectedProjectKey: "p3"},
+			{org: "o2", project: "mobile app", expectedOrg: "o2", expectedProjectKey: "p3"},
+		} {
+			gen := newGenerator(&BugsnagCLIConfig{Organization: tc.org, Project: tc.project})
+
+			assert.NoError(t, gen.configureOrganization())
+			assert.Equal(t, tc.expectedOrg, gen.value.organization)
+
+			if tc.project != "" {
+				assert.NoError(t, gen.configureProject())
+				assert.Equal(t, tc.expectedProjectKey, gen.value.project.Id)
+			}
+		}
+	})
+
+	t.Run("it fails on unknown flags", func(t *testing.T) {
+		gen := newGenerator(&BugsnagCLIConfig{Organization: "acme"})
+		assert.EqualError(t, gen.configureOrganization(), `organization "acme" not found`)
+
+		gen = newGenerator(&BugsnagCLIConfig{Organization: "o2", Project: "api"})
+		assert.NoError(t, gen.configureOrganization())
+		assert.EqualError(t, gen.configureProject(), `project "api" not found in the selected organization`)
+	})
+
+	t.Run("it selects options by position", func(t *testing.T) {
+		askSelect = func(_, _ string, options []string) (int, error) {
+			assert.Equal(t, options[0], options[1])
+			return 1, nil
+		}
+
+		gen := newGenerator(&BugsnagCLIConfig{})
+
+		assert.NoError(t, gen.configureOrganization())
+		assert.Equal(t, "o2", gen.value.organization)
+
+		assert.NoError(t, gen.configureProject())
+		assert.Equal(t, &projectConf{Id: "p2", Type: "rails", Name: "Web"}, gen.value.project)
+	})
+}
@@ -0,0 +1,233 @@
+package export
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// CheckpointFile is the name of the checkpoint file in the export directory.
+	CheckpointFile = ".checkpoint.json"
+
+	filePerm = 0o644
+)
+
+// Checkpoint records progress of an export so that an interrupted export can be resumed.
+type Checkpoint struct {
+	ProjectID string `json:"project_id"`
+	ErrorID   string `json:"error_id,omitempty"`
+	// Since and Until are the time range as given by the user, From and To
+	// are the range resolved on the first run so relative times stay fixed.
+	Since   string            `json:"since"`
+	Until   string            `json:"until"`
+	From    string            `json:"from"`
+	To      string            `json:"to"`
+	Filters map[string]string `json:"filters,omitempty"`
+	Gzip    bool              `json:"gzip"`
+
+	// Cursor is the next page to fetch, relative to the api endpoint.
+	Cursor string `json:"cursor"`
+	// File is the index of the file being written.
+	File int `json:"file"`
+	// Offset is the size of the file being written after the last complete page.
+	Offset int64 `json:"offset"`
+	// Lines is the number of events in the file being written.
+	Lines int `json:"lines"`
+	// Exported is the total number of events exported.
+	Exported  int    `json:"exported"`
+	Complete  bool   `json:"complete"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// LoadCheckpoint reads checkpoint from the export directory. It returns nil if there is none.
+func LoadCheckpoint(dir string) (*Checkpoint, error) {
+	b, err := os.ReadFile(filepath.Join(dir, CheckpointFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var cp Checkpoint
+	if err := json.Unmarshal(b, &cp); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint %s: %w", filepath.Join(dir, CheckpointFile), err)
+	}
+	return &cp, nil
+}
+
+// Save atomically writes the checkpoint to the export directory.
+func (c *Checkpoint) Save(dir string) error {
+	c.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(c); err != nil {
+		return err
+	}
+
+	tmp := filepath.Join(dir, CheckpointFile+".tmp")
+	if err := os.WriteFile(tmp, buf.Bytes(), filePerm); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, CheckpointFile))
+}
+
+// SameExport reports whether both checkpoints describe the same export as given by the user.
+func (c *Checkpoint) SameExport(o *Checkpoint) bool {
+	if c.ProjectID != o.ProjectID || c.ErrorID != o.ErrorID || c.Since != o.Since ||
+		c.Until != o.Until || c.Gzip != o.Gzip || len(c.Filters) != len(o.Filters) {
+		return false
+	}
+	for k, v := range c.Filters {
+		if ov, ok := o.Filters[k]; !ok || ov != v {
+			return false
+		}
+	}
+	return true
+}
+
+// FileName returns name of the export file with the given index.
+func FileName(index int, gz bool) string {
+	name := fmt.Sprintf("events-%05d.ndjson", index)
+	if gz {
+		name += ".gz"
+	}
+	return name
+}
+
+// Writer writes events as newline delimited json to files rotated after a
+// number of lines. Progress is tracked in the checkpoint it is created with.
+type Writer struct {
+	dir      string
+	maxLines int
+	cp       *Checkpoint
+	file     *os.File
+}
+
+// NewWriter opens the file recorded in the checkpoint, discarding anything
+// written after the last complete page. Files are rotated after maxLines
+// events, a value less than one disables rotation.
+func NewWriter(dir string, cp *Checkpoint, maxLines int) (*Writer, error) {
+	if cp.File < 1 {
+		cp.File, cp.Offset, cp.Lines = 1, 0, 0
+	}
+
+	w := Writer{dir: dir, maxLines: maxLines, cp: cp}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// Path returns path of the file being written.
+func (w *Writer) Path() string {
+	return filepath.Join(w.dir, FileName(w.cp.File, w.cp.Gzip))
+}
+
+// WritePage writes events to the current file, rotating it as needed, and
+// updates the checkpoint. Each chunk written to a gzip file is a complete
+// gzip member so that files can be truncated to any recorded offset.
+func (w *Writer) WritePage(events []json.RawMessage) error {
+	for len(events) > 0 {
+		if w.maxLines > 0 && w.cp.Lines >= w.maxLines {
+			if err := w.rotate(); err != nil {
+				return err
+			}
+		}
+
+		n := len(events)
+		if w.maxLines > 0 && w.cp.Lines+n > w.maxLines {
+			n = w.maxLines - w.cp.Lines
+		}
+
+		if err := w.write(events[:n]); err != nil {
+			return err
+		}
+		events = events[n:]
+	}
+
+	return nil
+}
+
+// Close closes the current file.
+func (w *Writer) Close() error {
+	return w.file.Close()
+}
+
+func (w *Writer) write(events []json.RawMessage) error {
+	var buf bytes.Buffer
+
+	for _, e := range events {
+		if err := json.Compact(&buf, e); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+	}
+
+	data := buf.Bytes()
+	if w.cp.Gzip {
+		var gz bytes.Buffer
+
+		zw := gzip.NewWriter(&gz)
+		if _, err := zw.Write(data); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+		data = gz.Bytes()
+	}
+
+	if _, err := w.file.Write(data); err != nil {
+		return err
+	}
+	if err := w.file.Sync(); err != nil {
+		return err
+	}
+
+	w.cp.Offset += int64(len(data))
+	w.cp.Lines += len(events)
+	w.cp.Exported += len(events)
+
+	return nil
+}
+
+func (w *Writer) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+
+	w.cp.File++
+	w.cp.Offset, w.cp.Lines = 0, 0
+
+	return w.open()
+}
+
+func (w *Writer) open() error {
+	f, err := os.OpenFile(w.Path(), os.O_CREATE|os.O_WRONLY, filePerm)
+	if err != nil {
+		return err
+	}
+	if err := f.Truncate(w.cp.Offset); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if _, err := f.Seek(w.cp.Offset, io.SeekStart); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	w.file = f
+	return nil
+}
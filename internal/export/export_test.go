@@ -0,0 +1,112 @@
+package export
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func events(from, to int) []json.RawMessage {
+	var out []json.RawMessage
+	for i := from; i <= to; i++ {
+		out = append(out, json.RawMessage(fmt.Sprintf(`{ "id": "e%d" }`, i)))
+	}
+	return out
+}
+
+func readLines(t *testing.T, path string, gz bool) []string {
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	var r io.Reader = f
+	if gz {
+		zr, err := gzip.NewReader(f)
+		assert.NoError(t, err)
+		r = zr
+	}
+
+	var lines []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		lines = append(lines, s.Text())
+	}
+	assert.NoError(t, s.Err())
+	return lines
+}
+
+func TestWriterRotates(t *testing.T) {
+	dir := t.TempDir()
+	cp := &Checkpoint{}
+
+	w, err := NewWriter(dir, cp, 3)
+	assert.NoError(t, err)
+	assert.NoError(t, w.WritePage(events(1, 2)))
+	assert.NoError(t, w.WritePage(events(3, 7)))
+	assert.NoError(t, w.Close())
+
+	assert.Equal(t, []string{`{"id":"e1"}`, `{"id":"e2"}`, `{"id":"e3"}`}, readLines(t, filepath.Join(dir, "events-00001.ndjson"), false))
+	assert.Equal(t, []string{`{"id":"e4"}`, `{"id":"e5"}`, `{"id":"e6"}`}, readLines(t, filepath.Join(dir, "events-00002.ndjson"), false))
+	assert.Equal(t, []string{`{"id":"e7"}`}, readLines(t, filepath.Join(dir, "events-00003.ndjson"), false))
+	assert.Equal(t, 3, cp.File)
+	assert.Equal(t, 1, cp.Lines)
+	assert.Equal(t, 7, cp.Exported)
+}
+
+func TestWriterResumesFromCheckpoint(t *testing.T) {
+	for _, gz := range []bool{false, true} {
+		dir := t.TempDir()
+		cp := &Checkpoint{ProjectID: "p1", Gzip: gz}
+
+		w, err := NewWriter(dir, cp, 0)
+		assert.NoError(t, err)
+		assert.NoError(t, w.WritePage(events(1, 2)))
+		assert.NoError(t, cp.Save(dir))
+
+		// A page written after the last checkpoint is discarded on resume.
+		assert.NoError(t, w.WritePage(events(3, 4)))
+		assert.NoError(t, w.Close())
+
+		saved, err := LoadCheckpoint(dir)
+		assert.NoError(t, err)
+		assert.True(t, saved.SameExport(&Checkpoint{ProjectID: "p1", Gzip: gz}))
+		assert.Equal(t, 2, saved.Exported)
+
+		w, err = NewWriter(dir, saved, 0)
+		assert.NoError(t, err)
+		assert.NoError(t, w.WritePage(events(3, 5)))
+		assert.NoError(t, w.Close())
+
+		lines := readLines(t, filepath.Join(dir, FileName(1, gz)), gz)
+		assert.Equal(t, []string{
+			`{"id":"e1"}`, `{"id":"e2"}`, `{"id":"e3"}`, `{"id":"e4"}`, `{"id":"e5"}`,
+		}, lines)
+	}
+}
+
+func TestLoadCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+
+	cp, err := LoadCheckpoint(dir)
+	assert.NoError(t, err)
+	assert.Nil(t, cp)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, CheckpointFile), []byte("{"), filePerm))
+	_, err = LoadCheckpoint(dir)
+	assert.Error(t, err)
+}
+
+func TestSameExport(t *testing.T) {
+	a := &Checkpoint{ProjectID: "p1", Since: "2022-07-01T00:00:00Z", Filters: map[string]string{"stage": "production"}}
+
+	assert.True(t, a.SameExport(&Checkpoint{ProjectID: "p1", Since: "2022-07-01T00:00:00Z", Filters: map[string]string{"stage": "production"}}))
+	assert.False(t, a.SameExport(&Checkpoint{ProjectID: "p1", Since: "2022-07-01T00:00:00Z"}))
+	assert.False(t, a.SameExport(&Checkpoint{ProjectID: "p2", Since: "2022-07-01T00:00:00Z", Filters: map[string]string{"stage": "production"}}))
+}
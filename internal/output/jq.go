@@ -0,0 +1,131 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/itchyny/gojq"
+	"gopkg.in/yaml.v3"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+)
+
+// CompileJQ parses and compiles a jq expression.
+func CompileJQ(expr string) (*gojq.Code, error) {
+	q, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jq expression %q: %w", expr, err)
+	}
+	code, err := gojq.Compile(q)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jq expression %q: %w", expr, err)
+	}
+	return code, nil
+}
+
+// JQ runs the jq expression against data and returns all results.
+func JQ(expr string, data interface{}) ([]interface{}, error) {
+	code, err := CompileJQ(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := Generic(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []interface{}
+
+	iter := code.Run(normalizeNumbers(g))
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := v.(error); ok {
+			return nil, fmt.Errorf("jq: %w", err)
+		}
+		out = append(out, v)
+	}
+
+	return out, nil
+}
+
+// printJQ writes results of the jq expression in the configured format. Strings are
+// written as is, like jq -r does, and csv like formats expect each result to be an array.
+func (o *Options) printJQ(w io.Writer, data interface{}) error {
+	results, err := JQ(o.JQ, data)
+	if err != nil {
+		return err
+	}
+
+	switch o.Format {
+	case FormatYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		for _, r := range results {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		return enc.Close()
+	case FormatTemplate:
+		for _, r := range results {
+			if err := printTemplate(w, o.Template, r); err != nil {
+				return err
+			}
+		}
+		return nil
+	case FormatCSV, FormatTSV, FormatPlain:
+		cw := csv.NewWriter(w)
+		if o.Format != FormatCSV {
+			cw.Comma = '\t'
+		}
+		for _, r := range results {
+			row, ok := r.([]interface{})
+			if !ok {
+				return fmt.Errorf("jq results must be arrays for %s output, eg: --jq '.[] | [.id, .name]'", o.Format)
+			}
+			rec := make([]string, 0, len(row))
+			for _, c := range row {
+				rec = append(rec, cell(c))
+			}
+			if err := cw.Write(rec); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+
+	for _, r := range results {
+		if s, ok := r.(string); ok {
+			if _, err := fmt.Fprintln(w, s); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := view.JSON(w, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cell(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case map[string]interface{}, []interface{}:
+		b, err := gojq.Marshal(t)
+		if err != nil {
+			return fmt.Sprint(t)
+		}
+		return string(b)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,79 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJQ(t *testing.T) {
+	actual, err := JQ(`.[] | select(.open_error_count > 10) | .name`, testData)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"Web App"}, actual)
+
+	actual, err = JQ(`map(.open_error_count) | add`, testData)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{42}, actual)
+
+	_, err = JQ(`.[] | .name |`, testData)
+	assert.Error(t, err)
+
+	_, err = JQ(`.[] | unknown_func`, testData)
+	assert.Error(t, err)
+
+	_, err = JQ(`.[] | .name | keys`, testData)
+	assert.Error(t, err)
+}
+
+func TestPrintJQ(t *testing.T) {
+	cases := []struct {
+		name     string
+		opts     Options
+		expected string
+	}{
+		{
+			name:     "it prints strings raw",
+			opts:     Options{Format: FormatTable, JQ: `.[].name`},
+			expected: "Web App\niOS, App\n",
+		},
+		{
+			name:     "it prints other values as json",
+			opts:     Options{Format: FormatJSON, JQ: `.[0] | {id, stages: .release_stages}`},
+			expected: "{\n  \"id\": \"1\",\n  \"stages\": [\n    \"production\",\n    \"staging\"\n  ]\n}\n",
+		},
+		{
+			name:     "it prints yaml",
+			opts:     Options{Format: FormatYAML, JQ: `.[] | {id}`},
+			expected: "id: \"1\"\n---\nid: \"2\"\n",
+		},
+		{
+			name:     "it prints arrays as csv rows",
+			opts:     Options{Format: FormatCSV, JQ: `.[] | [.id, .name, .open_error_count, .release_stages]`},
+			expected: "1,Web App,42,\"[\"\"production\"\",\"\"staging\"\"]\"\n2,\"iOS, App\",0,\n",
+		},
+		{
+			name:     "it applies template to each result",
+			opts:     Options{Format: FormatTemplate, Template: `{{.id}}={{.open_error_count}}`, JQ: `.[]`},
+			expected: "1=42\n2=0\n",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b bytes.Buffer
+
+			assert.NoError(t, tc.opts.Print(&b, testData, testTable))
+			assert.Equal(t, tc.expected, b.String())
+		})
+	}
+
+	var b bytes.Buffer
+
+	opts := Options{Format: FormatCSV, JQ: `.[].id`}
+	assert.Error(t, opts.Print(&b, testData, testTable))
+}
@@ -0,0 +1,332 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/query"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+)
+
+const (
+	// FormatTable renders human readable tables and detail views.
+	FormatTable Format = "table"
+	// FormatJSON renders the api payload as json.
+	FormatJSON Format = "json"
+	// FormatYAML renders the api payload as yaml.
+	FormatYAML Format = "yaml"
+	// FormatCSV renders tabular view as comma separated values.
+	FormatCSV Format = "csv"
+	// FormatTSV renders tabular view as tab separated values.
+	FormatTSV Format = "tsv"
+	// FormatPlain renders tabular view as tab separated values without a header.
+	FormatPlain Format = "plain"
+	// FormatMarkdown renders tabular view as a markdown table, or the view's own markdown if it has one.
+	FormatMarkdown Format = "markdown"
+	// FormatTemplate renders the api payload using a go template.
+	FormatTemplate Format = "template"
+
+	templatePrefix = "template="
+)
+
+// ErrUnsupportedFormat is returned if a view cannot be rendered in the requested format.
+var ErrUnsupportedFormat = fmt.Errorf("output format is not supported by this command")
+
+// Format is an output format.
+type Format string
+
+// Tabular is implemented by views that can be represented as a table.
+type Tabular interface {
+	Table() *view.Table
+}
+
+// Renderer is implemented by views with a human readable representation.
+type Renderer interface {
+	Render(io.Writer) error
+}
+
+// Markdowner is implemented by views with a markdown representation other than a single table.
+type Markdowner interface {
+	Markdown(io.Writer) error
+}
+
+// Options holds output options set via persistent flags.
+type Options struct {
+	Format   Format
+	Template string
+	Columns  []string
+	JQ       string
+}
+
+// ParseFormat parses value of the --output flag, eg: json or template={{.id}}.
+func ParseFormat(s string) (Format, string, error) {
+	if strings.HasPrefix(s, templatePrefix) {
+		tmpl := strings.TrimPrefix(s, templatePrefix)
+		if tmpl == "" {
+			return "", "", fmt.Errorf("template cannot be empty, eg: --output 'template={{.id}}'")
+		}
+		return FormatTemplate, tmpl, nil
+	}
+
+	switch f := Format(strings.ToLower(s)); f {
+	case "":
+		return FormatTable, "", nil
+	case "md":
+		return FormatMarkdown, "", nil
+	case FormatTable, FormatJSON, FormatYAML, FormatCSV, FormatTSV, FormatPlain, FormatMarkdown:
+		return f, "", nil
+	}
+
+	return "", "", fmt.Errorf(
+		"invalid output format %q, must be one of: table, json, yaml, csv, tsv, plain, markdown or template=<go-template>", s,
+	)
+}
+
+// FromFlags reads output options from --output and --columns flags.
+func FromFlags(flags query.FlagParser) (*Options, error) {
+	o, err := flags.GetString("output")
+	if err != nil {
+		return nil, err
+	}
+	format, tmpl, err := ParseFormat(o)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := flags.GetString("columns")
+	if err != nil {
+		return nil, err
+	}
+
+	var columns []string
+	for _, c := range strings.Split(cols, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			columns = append(columns, c)
+		}
+	}
+
+	jq, err := flags.GetString("jq")
+	if err != nil {
+		return nil, err
+	}
+	if jq != "" {
+		if _, err := CompileJQ(jq); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Options{Format: format, Template: tmpl, Columns: columns, JQ: jq}, nil
+}
+
+// IsHuman checks if the output is meant to be read by humans.
+func (o *Options) IsHuman() bool {
+	return o.Format == FormatTable && o.JQ == ""
+}
+
+// IsPayload checks if the output is rendered from data rather than from the view.
+func (o *Options) IsPayload() bool {
+	switch o.Format {
+	case FormatJSON, FormatYAML, FormatTemplate:
+		return true
+	}
+	return o.JQ != ""
+}
+
+// Print writes data in the configured format to w. Payload formats, ie: json, yaml
+// and template, are rendered from data while all others are rendered from v.
+// If a jq expression is set, it is applied to data and v is ignored.
+func (o *Options) Print(w io.Writer, data interface{}, v interface{}) error {
+	if o.JQ != "" {
+		return o.printJQ(w, data)
+	}
+
+	switch o.Format {
+	case FormatJSON:
+		return view.JSON(w, data)
+	case FormatYAML:
+		return printYAML(w, data)
+	case FormatTemplate:
+		return printTemplate(w, o.Template, data)
+	}
+
+	if r, ok := v.(Renderer); ok && o.Format == FormatTable && len(o.Columns) == 0 {
+		return r.Render(w)
+	}
+	if m, ok := v.(Markdowner); ok && o.Format == FormatMarkdown && len(o.Columns) == 0 {
+		return m.Markdown(w)
+	}
+
+	tv, ok := v.(Tabular)
+	if !ok {
+		return ErrUnsupportedFormat
+	}
+	t, err := selectColumns(tv.Table(), o.Columns)
+	if err != nil {
+		return err
+	}
+
+	switch o.Format {
+	case FormatCSV:
+		return printSeparated(w, t, ',', true)
+	case FormatTSV:
+		return printSeparated(w, t, '\t', true)
+	case FormatPlain:
+		return printSeparated(w, t, '\t', false)
+	case FormatMarkdown:
+		return t.Markdown(w)
+	default:
+		return t.Render(w)
+	}
+}
+
+// Generic converts data to generic json values so that json field
+// names are used by yaml and template outputs.
+func Generic(data interface{}) (interface{}, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var out interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	err = dec.Decode(&out)
+
+	return out, err
+}
+
+func printYAML(w io.Writer, data interface{}) error {
+	g, err := Generic(data)
+	if err != nil {
+		return err
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(normalizeNumbers(g)); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// normalizeNumbers converts json numbers to numeric values
+// as yaml would quote them and jq doesn't understand them.
+func normalizeNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			t[k] = normalizeNumbers(val)
+		}
+	case []interface{}:
+		for i, val := range t {
+			t[i] = normalizeNumbers(val)
+		}
+	case json.Number:
+		if i, err := strconv.Atoi(t.String()); err == nil {
+			return i
+		}
+		if f, err := t.Float64(); err == nil {
+			return f
+		}
+	}
+	return v
+}
+
+func printTemplate(w io.Writer, tmpl string, data interface{}) error {
+	t, err := template.New("output").Funcs(templateFuncs).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+
+	g, err := Generic(data)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, g); err != nil {
+		return err
+	}
+
+	// Make sure the prompt starts on a new line.
+	if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+func printSeparated(w io.Writer, t *view.Table, sep rune, header bool) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = sep
+
+	if header {
+		if err := cw.Write(t.Header); err != nil {
+			return err
+		}
+	}
+	for _, row := range t.Rows {
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func selectColumns(t *view.Table, columns []string) (*view.Table, error) {
+	if len(columns) == 0 {
+		return t, nil
+	}
+
+	idx := make(map[string]int, len(t.Header))
+	for i, h := range t.Header {
+		idx[columnKey(h)] = i
+	}
+
+	indices := make([]int, 0, len(columns))
+	out := &view.Table{}
+	for _, c := range columns {
+		i, ok := idx[columnKey(c)]
+		if !ok {
+			available := make([]string, 0, len(t.Header))
+			for _, h := range t.Header {
+				available = append(available, columnKey(h))
+			}
+			return nil, fmt.Errorf("unknown column %q, available columns are: %s", c, strings.Join(available, ", "))
+		}
+		indices = append(indices, i)
+		out.Header = append(out.Header, t.Header[i])
+	}
+
+	for _, row := range t.Rows {
+		r := make([]string, 0, len(indices))
+		for _, i := range indices {
+			if i < len(row) {
+				r = append(r, row[i])
+			} else {
+				r = append(r, "")
+			}
+		}
+		out.Rows = append(out.Rows, r)
+	}
+
+	return out, nil
+}
+
+// columnKey normalizes column names so that "LAST SEEN", "last-seen" and "last_seen" match.
+func columnKey(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(s)
+}
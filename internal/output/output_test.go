@@ -0,0 +1,188 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+)
+
+type project struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Errors int      `json:"open_error_count"`
+	Stages []string `json:"release_stages"`
+}
+
+var (
+	testData = []project{
+		{ID: "1", Name: "Web App", Errors: 42, Stages: []string{"production", "staging"}},
+		{ID: "2", Name: "iOS, App", Errors: 0},
+	}
+	testTable = &view.Table{
+		Header: []string{"ID", "NAME", "OPEN ERRORS"},
+		Rows:   [][]string{{"1", "Web App", "42"}, {"2", "iOS, App", "0"}},
+	}
+)
+
+func TestParseFormat(t *testing.T) {
+	cases := []struct {
+		input    string
+		format   Format
+		template string
+		err      bool
+	}{
+		{input: "", format: FormatTable},
+		{input: "table", format: FormatTable},
+		{input: "JSON", format: FormatJSON},
+		{input: "yaml", format: FormatYAML},
+		{input: "csv", format: FormatCSV},
+		{input: "tsv", format: FormatTSV},
+		{input: "plain", format: FormatPlain},
+		{input: "markdown", format: FormatMarkdown},
+		{input: "md", format: FormatMarkdown},
+		{input: "template={{.id}}", format: FormatTemplate, template: "{{.id}}"},
+		{input: "template=", err: true},
+		{input: "xml", err: true},
+	}
+
+	for _, tc := range cases {
+		format, tmpl, err := ParseFormat(tc.input)
+		if tc.err {
+			assert.Error(t, err, tc.input)
+			continue
+		}
+		assert.NoError(t, err, tc.input)
+		assert.Equal(t, tc.format, format, tc.input)
+		assert.Equal(t, tc.template, tmpl, tc.input)
+	}
+}
+
+func TestPrint(t *testing.T) {
+	cases := []struct {
+		name     string
+		opts     Options
+		expected string
+	}{
+		{
+			name: "it renders table",
+			opts: Options{Format: FormatTable},
+			expected: "ID  NAME      OPEN ERRORS\n" +
+				"1   Web App   42\n" +
+				"2   iOS, App  0\n",
+		},
+		{
+			name: "it renders selected columns",
+			opts: Options{Format: FormatTable, Columns: []string{"open_errors", "id"}},
+			expected: "OPEN ERRORS  ID\n" +
+				"42           1\n" +
+				"0            2\n",
+		},
+		{
+			name:     "it renders csv",
+			opts:     Options{Format: FormatCSV},
+			expected: "ID,NAME,OPEN ERRORS\n1,Web App,42\n2,\"iOS, App\",0\n",
+		},
+		{
+			name:     "it renders tsv",
+			opts:     Options{Format: FormatTSV, Columns: []string{"Name"}},
+			expected: "NAME\nWeb App\niOS, App\n",
+		},
+		{
+			name:     "it renders plain",
+			opts:     Options{Format: FormatPlain, Columns: []string{"id", "open-errors"}},
+			expected: "1\t42\n2\t0\n",
+		},
+		{
+			name: "it renders markdown",
+			opts: Options{Format: FormatMarkdown, Columns: []string{"name", "open errors"}},
+			expected: "| NAME | OPEN ERRORS |\n" +
+				"| --- | --- |\n" +
+				"| Web App | 42 |\n" +
+				"| iOS, App | 0 |\n",
+		},
+		{
+			name: "it renders json",
+			opts: Options{Format: FormatJSON},
+			expected: `[
+  {
+    "id": "1",
+    "name": "Web App",
+    "open_error_count": 42,
+    "release_stages": [
+      "production",
+      "staging"
+    ]
+  },
+  {
+    "id": "2",
+    "name": "iOS, App",
+    "open_error_count": 0,
+    "release_stages": null
+  }
+]
+`,
+		},
+		{
+			name: "it renders yaml",
+			opts: Options{Format: FormatYAML},
+			expected: `- id: "1"
+  name: Web App
+  open_error_count: 42
+  release_stages:
+    - production
+    - staging
+- id: "2"
+  name: iOS, App
+  open_error_count: 0
+  release_stages: null
+`,
+		},
+		{
+			name:     "it renders template",
+			opts:     Options{Format: FormatTemplate, Template: `{{range .}}{{.id}}: {{upper .name}} [{{join .release_stages ","}}]{{"\n"}}{{end}}`},
+			expected: "1: WEB APP [production,staging]\n2: IOS, APP []\n",
+		},
+		{
+			name:     "it truncates values in template",
+			opts:     Options{Format: FormatTemplate, Template: `{{range .}}{{truncate .name 4}};{{end}}`},
+			expected: "Web…;iOS…;\n",
+		},
+		{
+			name:     "it appends new line to template output",
+			opts:     Options{Format: FormatTemplate, Template: `{{len .}}`},
+			expected: "2\n",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b bytes.Buffer
+
+			assert.NoError(t, tc.opts.Print(&b, testData, testTable))
+			assert.Equal(t, tc.expected, b.String())
+		})
+	}
+}
+
+func TestPrintErrors(t *testing.T) {
+	var b bytes.Buffer
+
+	opts := Options{Format: FormatTable, Columns: []string{"unknown"}}
+	assert.EqualError(t, opts.Print(&b, testData, testTable), `unknown column "unknown", available columns are: id, name, open_errors`)
+
+	opts = Options{Format: FormatCSV}
+	assert.Equal(t, ErrUnsupportedFormat, opts.Print(&b, testData, struct{}{}))
+
+	opts = Options{Format: FormatTemplate, Template: "{{.id"}
+	assert.Error(t, opts.Print(&b, testData, testTable))
+
+	opts = Options{Format: FormatTemplate, Template: "{{range .}}{{truncate .name 0}}{{end}}"}
+	assert.ErrorContains(t, opts.Print(&b, testData, testTable), "truncate length must be positive, got 0")
+}
@@ -0,0 +1,48 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/internal/view"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+var templateFuncs = template.FuncMap{
+	// json encodes the value as json, eg: {{json .release_stages}}
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	// join joins a list with the separator, eg: {{join .release_stages ","}}
+	"join": func(v interface{}, sep string) string {
+		if v == nil {
+			return ""
+		}
+		items, ok := v.([]interface{})
+		if !ok {
+			return fmt.Sprint(v)
+		}
+		s := make([]string, 0, len(items))
+		for _, i := range items {
+			s = append(s, fmt.Sprint(i))
+		}
+		return strings.Join(s, sep)
+	},
+	// truncate shortens the value to given length, eg: {{truncate .message 40}}
+	"truncate": func(v interface{}, length int) (string, error) {
+		if length < 1 {
+			return "", fmt.Errorf("truncate length must be positive, got %d", length)
+		}
+		return view.Shorten(fmt.Sprint(v), length), nil
+	},
+	// date formats an api timestamp in human readable format, eg: {{date .last_seen}}
+	"date": func(v interface{}) string {
+		return cmdutil.FormatDateTimeHuman(fmt.Sprint(v), bugsnag.ISO8601)
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
@@ -0,0 +1,110 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+// ValidateFields checks that every filter key is one of the given event fields or
+// a field of a filter alias, suggesting the nearest field for unknown keys.
+func ValidateFields(filters bugsnag.Filters, fields []string) error {
+	known := make(map[string]struct{}, len(fields)+len(FilterAliases))
+	for _, f := range fields {
+		known[f] = struct{}{}
+	}
+	for _, f := range FilterAliases {
+		known[f] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(filters))
+	for k := range filters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if _, ok := known[k]; ok {
+			continue
+		}
+
+		msg := fmt.Sprintf("unknown filter field %q", k)
+		candidates := append(append([]string{}, fields...), aliasKeys()...)
+		if s := Nearest(k, candidates); s != "" {
+			msg += fmt.Sprintf(", did you mean %q?", s)
+		}
+		return fmt.Errorf("%s\nRun 'bugsnag fields list' to see fields available in the project", msg)
+	}
+
+	return nil
+}
+
+// Nearest returns the candidate closest to s by edit distance, or an
+// empty string if none is close enough to be a likely typo.
+func Nearest(s string, candidates []string) string {
+	var (
+		best     string
+		bestDist = -1
+	)
+
+	for _, c := range candidates {
+		d := distance(strings.ToLower(s), strings.ToLower(c))
+		if bestDist == -1 || d < bestDist || (d == bestDist && c < best) {
+			best, bestDist = c, d
+		}
+	}
+
+	maxDist := len([]rune(s)) / 3
+	if maxDist < 2 {
+		maxDist = 2
+	}
+	if bestDist == -1 || bestDist > maxDist {
+		return ""
+	}
+	return best
+}
+
+func aliasKeys() []string {
+	keys := make([]string, 0, len(FilterAliases))
+	for k := range FilterAliases {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// distance returns the levenshtein distance between a and b.
+func distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func minInt(first int, rest ...int) int {
+	m := first
+	for _, n := range rest {
+		if n < m {
+			m = n
+		}
+	}
+	return m
+}
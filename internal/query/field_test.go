@@ -0,0 +1,54 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+func TestNearest(t *testing.T) {
+	candidates := []string{"app.version", "app.release_stage", "device.os_name", "user.id"}
+
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "app.verison", expected: "app.version"},
+		{input: "device.osname", expected: "device.os_name"},
+		{input: "User.Id", expected: "user.id"},
+		{input: "browser", expected: ""},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.input, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, Nearest(tc.input, candidates))
+		})
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	fields := []string{"app.version", "device.os_name"}
+
+	valid := bugsnag.Filters{}
+	valid.Add("device.os_name", bugsnag.FilterTypeEq, "iOS")
+	valid.Add("error.status", bugsnag.FilterTypeEq, "open")
+	assert.NoError(t, ValidateFields(valid, fields))
+
+	typo := bugsnag.Filters{}
+	typo.Add("devce.os_name", bugsnag.FilterTypeEq, "iOS")
+	err := ValidateFields(typo, fields)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `did you mean "device.os_name"?`)
+
+	unknown := bugsnag.Filters{}
+	unknown.Add("browser", bugsnag.FilterTypeEq, "Chrome")
+	err = ValidateFields(unknown, fields)
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "did you mean")
+}
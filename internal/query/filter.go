@@ -0,0 +1,118 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+// FilterAliases maps short filter keys accepted by the --filter flag to bugsnag event fields.
+var FilterAliases = map[string]string{
+	"status":        "error.status",
+	"stage":         "app.release_stage",
+	"release_stage": "app.release_stage",
+	"severity":      "event.severity",
+	"version":       "app.version",
+	"app_version":   "app.version",
+	"user":          "user.id",
+	"user_id":       "user.id",
+	"since":         "event.since",
+	"before":        "event.before",
+}
+
+var allowedFilterValues = map[string][]string{
+	"error.status":   {"open", "for_review", "in_progress", "fixed", "snoozed", "ignored"},
+	"event.severity": {"error", "warning", "info"},
+}
+
+// FilterField returns bugsnag event field for the given filter key.
+func FilterField(key string) string {
+	key = strings.TrimSpace(key)
+	if field, ok := FilterAliases[key]; ok {
+		return field
+	}
+	return key
+}
+
+// FilterHelp returns usage of the --filter flag filtering the given noun, eg: errors.
+// The shortest alias of each field is listed, except for aliases of excluded fields.
+func FilterHelp(noun string, excludeFields ...string) string {
+	shortest := make(map[string]string, len(FilterAliases))
+	for alias, field := range FilterAliases {
+		if s, ok := shortest[field]; !ok || len(alias) < len(s) || (len(alias) == len(s) && alias < s) {
+			shortest[field] = alias
+		}
+	}
+	for _, f := range excludeFields {
+		delete(shortest, f)
+	}
+
+	aliases := make([]string, 0, len(shortest))
+	for _, alias := range shortest {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	return fmt.Sprintf(
+		"Filter %s, can be repeated. Accepts bugsnag event fields or one of:\n%s.\n"+
+			"Prefix a value with '!' to exclude it, leave it empty to match missing fields",
+		noun, strings.Join(aliases, ", "),
+	)
+}
+
+// Filters parses repeated --filter key=value flags to bugsnag filters.
+//
+// A value prefixed with '!' excludes matching events and an empty
+// value matches events where the field is not set, eg:
+//
+//	--filter status=open --filter stage=!development --filter user=
+func Filters(flags FlagParser) (bugsnag.Filters, error) {
+	raw, err := flags.GetStringToString("filter")
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make(bugsnag.Filters, len(raw))
+	for k, v := range raw {
+		field := FilterField(k)
+		if field == "" {
+			return nil, fmt.Errorf("invalid filter %q: key cannot be empty", k+"="+v)
+		}
+
+		typ := bugsnag.FilterTypeEq
+		if strings.HasPrefix(v, "!") {
+			typ, v = bugsnag.FilterTypeNe, strings.TrimPrefix(v, "!")
+		}
+		if v == "" {
+			if typ == bugsnag.FilterTypeNe {
+				return nil, fmt.Errorf("invalid filter %q: value cannot be empty", k+"=!")
+			}
+			typ = bugsnag.FilterTypeEmpty
+			v = "true"
+		} else if err := validateFilterValue(field, v); err != nil {
+			return nil, err
+		}
+
+		filters.Add(field, typ, v)
+	}
+
+	return filters, nil
+}
+
+func validateFilterValue(field, value string) error {
+	allowed, ok := allowedFilterValues[field]
+	if !ok {
+		return nil
+	}
+	for _, a := range allowed {
+		if a == value {
+			return nil
+		}
+	}
+	return fmt.Errorf(
+		"invalid value %q for filter %q, allowed values are: %s",
+		value, field, strings.Join(allowed, ", "),
+	)
+}
@@ -0,0 +1,107 @@
+package query
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+type filterFlagParser struct {
+	filters map[string]string
+	err     error
+}
+
+func (f filterFlagParser) GetBool(string) (bool, error)            { return false, nil }
+func (f filterFlagParser) GetInt(string) (int, error)              { return 0, nil }
+func (f filterFlagParser) GetString(string) (string, error)        { return "", nil }
+func (f filterFlagParser) GetStringArray(string) ([]string, error) { return nil, nil }
+func (f filterFlagParser) GetUint(string) (uint, error)            { return 0, nil }
+func (f filterFlagParser) Set(string, string) error                { return nil }
+
+func (f filterFlagParser) GetStringToString(string) (map[string]string, error) {
+	return f.filters, f.err
+}
+
+func TestFilters(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    map[string]string
+		expected bugsnag.Filters
+		err      bool
+	}{
+		{
+			name:     "it returns empty filters if none is set",
+			input:    nil,
+			expected: bugsnag.Filters{},
+		},
+		{
+			name:  "it expands aliases",
+			input: map[string]string{"status": "open", "stage": "production", "since": "7d"},
+			expected: bugsnag.Filters{
+				"error.status":      {{Type: bugsnag.FilterTypeEq, Value: "open"}},
+				"app.release_stage": {{Type: bugsnag.FilterTypeEq, Value: "production"}},
+				"event.since":       {{Type: bugsnag.FilterTypeEq, Value: "7d"}},
+			},
+		},
+		{
+			name:  "it keeps event fields as is",
+			input: map[string]string{"device.os_name": "iOS"},
+			expected: bugsnag.Filters{
+				"device.os_name": {{Type: bugsnag.FilterTypeEq, Value: "iOS"}},
+			},
+		},
+		{
+			name:  "it parses negated and empty values",
+			input: map[string]string{"stage": "!development", "user": ""},
+			expected: bugsnag.Filters{
+				"app.release_stage": {{Type: bugsnag.FilterTypeNe, Value: "development"}},
+				"user.id":           {{Type: bugsnag.FilterTypeEmpty, Value: "true"}},
+			},
+		},
+		{
+			name:  "it fails for invalid status",
+			input: map[string]string{"status": "closed"},
+			err:   true,
+		},
+		{
+			name:  "it fails for negated empty value",
+			input: map[string]string{"severity": "!"},
+			err:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := Filters(filterFlagParser{filters: tc.input})
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+
+	_, err := Filters(filterFlagParser{err: fmt.Errorf("oops")})
+	assert.Error(t, err)
+}
+
+func TestFilterHelp(t *testing.T) {
+	assert.Equal(t,
+		"Filter errors, can be repeated. Accepts bugsnag event fields or one of:\n"+
+			"before, severity, since, stage, status, user, version.\n"+
+			"Prefix a value with '!' to exclude it, leave it empty to match missing fields",
+		FilterHelp("errors"),
+	)
+
+	help := FilterHelp("events", "event.since", "event.before")
+	assert.Contains(t, help, "one of:\nseverity, stage, status, user, version.\n")
+	assert.NotContains(t, help, "since")
+}
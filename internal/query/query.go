@@ -3,6 +3,7 @@ package query
 // FlagParser wraps pflag.FlagSet struct.
 type FlagParser interface {
 	GetBool(string) (bool, error)
+	GetInt(string) (int, error)
 	GetString(string) (string, error)
 	GetStringArray(string) ([]string, error)
 	GetStringToString(string) (map[string]string, error)
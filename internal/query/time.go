@@ -0,0 +1,78 @@
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+var relativeTimeRegex = regexp.MustCompile(`^(\d+)([mhdw])$`)
+
+var relativeTimeUnits = map[string]time.Duration{
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// ParseTime parses an absolute or relative time. Accepted formats are RFC3339
+// timestamps, dates like 2022-07-01 and durations ago like 30m, 12h, 7d or 2w.
+func ParseTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+
+	if m := relativeTimeRegex.FindStringSubmatch(s); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+		return now.Add(-time.Duration(n) * relativeTimeUnits[m[2]]), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q, eg: 2022-07-01, 2022-07-01T15:04:05Z or 7d", s)
+}
+
+// TimeRange parses --since and --until flags and adds them to the filters
+// as event.since and event.before. Unset flags are ignored.
+func TimeRange(flags FlagParser, filters bugsnag.Filters, now time.Time) error {
+	var since, until time.Time
+
+	for _, f := range []struct {
+		flag  string
+		field string
+		out   *time.Time
+	}{
+		{flag: "since", field: "event.since", out: &since},
+		{flag: "until", field: "event.before", out: &until},
+	} {
+		raw, err := flags.GetString(f.flag)
+		if err != nil {
+			return err
+		}
+		if raw == "" {
+			continue
+		}
+
+		t, err := ParseTime(raw, now)
+		if err != nil {
+			return fmt.Errorf("--%s: %w", f.flag, err)
+		}
+		*f.out = t
+
+		filters.Add(f.field, bugsnag.FilterTypeEq, t.UTC().Format(time.RFC3339))
+	}
+
+	if !since.IsZero() && !until.IsZero() && !since.Before(until) {
+		return fmt.Errorf("--since must be before --until")
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+type timeFlagParser struct {
+	filterFlagParser
+	values map[string]string
+}
+
+func (f timeFlagParser) GetString(name string) (string, error) { return f.values[name], nil }
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2022, 7, 10, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		input    string
+		expected time.Time
+		err      bool
+	}{
+		{input: "30m", expected: now.Add(-30 * time.Minute)},
+		{input: "12h", expected: now.Add(-12 * time.Hour)},
+		{input: "7d", expected: time.Date(2022, 7, 3, 12, 0, 0, 0, time.UTC)},
+		{input: "2w", expected: time.Date(2022, 6, 26, 12, 0, 0, 0, time.UTC)},
+		{input: "2022-07-01", expected: time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{input: "2022-07-01T15:04:05Z", expected: time.Date(2022, 7, 1, 15, 4, 5, 0, time.UTC)},
+		{input: "yesterday", err: true},
+		{input: "7y", err: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.input, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := ParseTime(tc.input, now)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tc.expected.Equal(actual), "expected %s, got %s", tc.expected, actual)
+		})
+	}
+}
+
+func TestTimeRange(t *testing.T) {
+	now := time.Date(2022, 7, 10, 12, 0, 0, 0, time.UTC)
+
+	filters := bugsnag.Filters{}
+	err := TimeRange(timeFlagParser{values: map[string]string{"since": "1d", "until": "2022-07-10"}}, filters, now)
+	assert.NoError(t, err)
+	assert.Equal(t, bugsnag.Filters{
+		"event.since":  {{Type: bugsnag.FilterTypeEq, Value: "2022-07-09T12:00:00Z"}},
+		"event.before": {{Type: bugsnag.FilterTypeEq, Value: "2022-07-10T00:00:00Z"}},
+	}, filters)
+
+	err = TimeRange(timeFlagParser{values: map[string]string{"since": "2022-07-10", "until": "2d"}}, bugsnag.Filters{}, now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,100 @@
+package release
+
+import (
+	"sort"
+	"time"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+// Change is an error seen in either of the compared releases.
+type Change struct {
+	Error *bugsnag.Error `json:"error"`
+	// EventsFrom and EventsTo are the number of events of the error in each release.
+	EventsFrom int `json:"events_from"`
+	EventsTo   int `json:"events_to"`
+	UsersFrom  int `json:"users_from"`
+	UsersTo    int `json:"users_to"`
+}
+
+// Delta returns the change in the number of events between the releases.
+func (c *Change) Delta() int {
+	return c.EventsTo - c.EventsFrom
+}
+
+// Diff compares errors seen in two releases.
+type Diff struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// New errors were seen for the first time in the later release.
+	New []*Change `json:"new"`
+	// Regressed errors were seen before, but not in the earlier release.
+	Regressed []*Change `json:"regressed"`
+	// Fixed errors were seen in the earlier release but not in the later one.
+	Fixed []*Change `json:"fixed"`
+	// Changed errors were seen in both releases.
+	Changed []*Change `json:"changed"`
+}
+
+// Compare compares errors of release from with errors of release to. Errors
+// are expected to be filtered by release, so that their event counts and first
+// seen times are those of the release, while the unfiltered first seen time is
+// used to tell new errors from regressions.
+func Compare(from, to string, before, after []*bugsnag.Error) *Diff {
+	d := Diff{From: from, To: to, New: []*Change{}, Regressed: []*Change{}, Fixed: []*Change{}, Changed: []*Change{}}
+
+	seen := make(map[string]*bugsnag.Error, len(before))
+	for _, e := range before {
+		seen[e.ID] = e
+	}
+
+	for _, e := range after {
+		c := Change{Error: e, EventsTo: e.Events, UsersTo: e.Users}
+
+		prev, ok := seen[e.ID]
+		switch {
+		case ok:
+			c.EventsFrom, c.UsersFrom = prev.Events, prev.Users
+			d.Changed = append(d.Changed, &c)
+			delete(seen, e.ID)
+		case isNew(e):
+			d.New = append(d.New, &c)
+		default:
+			d.Regressed = append(d.Regressed, &c)
+		}
+	}
+
+	for _, e := range before {
+		if _, ok := seen[e.ID]; ok {
+			d.Fixed = append(d.Fixed, &Change{Error: e, EventsFrom: e.Events, UsersFrom: e.Users})
+		}
+	}
+
+	sortChanges(d.New, func(c *Change) int { return c.EventsTo })
+	sortChanges(d.Regressed, func(c *Change) int { return c.EventsTo })
+	sortChanges(d.Fixed, func(c *Change) int { return c.EventsFrom })
+	sortChanges(d.Changed, func(c *Change) int { return c.Delta() })
+
+	return &d
+}
+
+// isNew checks if the error was first seen in the release it was filtered by.
+// Errors without timestamps are considered new.
+func isNew(e *bugsnag.Error) bool {
+	first, err := time.Parse(time.RFC3339, e.FirstSeen)
+	if err != nil {
+		return true
+	}
+	firstEver, err := time.Parse(time.RFC3339, e.FirstSeenUnfiltered)
+	if err != nil {
+		return true
+	}
+	return !firstEver.Before(first)
+}
+
+// sortChanges sorts changes by key, largest first, keeping the api order for equal keys.
+func sortChanges(changes []*Change, key func(*Change) int) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		return key(changes[i]) > key(changes[j])
+	})
+}
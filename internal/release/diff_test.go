@@ -0,0 +1,57 @@
+package release
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+func TestCompare(t *testing.T) {
+	before := []*bugsnag.Error{
+		{ID: "a", Events: 10, Users: 4, FirstSeen: "2022-07-01T10:00:00.000Z", FirstSeenUnfiltered: "2022-06-01T10:00:00.000Z"},
+		{ID: "b", Events: 5, Users: 2, FirstSeen: "2022-07-01T10:00:00.000Z", FirstSeenUnfiltered: "2022-07-01T10:00:00.000Z"},
+		{ID: "c", Events: 3, Users: 1, FirstSeen: "2022-07-02T10:00:00.000Z", FirstSeenUnfiltered: "2022-07-02T10:00:00.000Z"},
+	}
+	after := []*bugsnag.Error{
+		{ID: "a", Events: 4, Users: 3, FirstSeen: "2022-07-12T10:00:00.000Z", FirstSeenUnfiltered: "2022-06-01T10:00:00.000Z"},
+		{ID: "c", Events: 30, Users: 9, FirstSeen: "2022-07-12T10:00:00.000Z", FirstSeenUnfiltered: "2022-07-02T10:00:00.000Z"},
+		{ID: "d", Events: 2, Users: 2, FirstSeen: "2022-07-12T11:00:00.000Z", FirstSeenUnfiltered: "2022-07-12T11:00:00.000Z"},
+		{ID: "e", Events: 7, Users: 1, FirstSeen: "2022-07-12T12:00:00.000Z", FirstSeenUnfiltered: "2022-05-20T09:00:00.000Z"},
+		{ID: "f", Events: 9, Users: 5},
+	}
+
+	d := Compare("2.4.0", "2.4.1", before, after)
+
+	ids := func(changes []*Change) []string {
+		out := make([]string, 0, len(changes))
+		for _, c := range changes {
+			out = append(out, c.Error.ID)
+		}
+		return out
+	}
+
+	assert.Equal(t, "2.4.0", d.From)
+	assert.Equal(t, "2.4.1", d.To)
+	assert.Equal(t, []string{"f", "d"}, ids(d.New))
+	assert.Equal(t, []string{"e"}, ids(d.Regressed))
+	assert.Equal(t, []string{"b"}, ids(d.Fixed))
+	assert.Equal(t, []string{"c", "a"}, ids(d.Changed))
+
+	assert.Equal(t, 27, d.Changed[0].Delta())
+	assert.Equal(t, -6, d.Changed[1].Delta())
+	assert.Equal(t, 3, d.Changed[1].UsersTo)
+	assert.Equal(t, 4, d.Changed[1].UsersFrom)
+	assert.Equal(t, -5, d.Fixed[0].Delta())
+}
+
+func TestCompareEmpty(t *testing.T) {
+	d := Compare("1.0.0", "1.0.1", nil, nil)
+
+	assert.NotNil(t, d.New)
+	assert.Empty(t, d.New)
+	assert.Empty(t, d.Regressed)
+	assert.Empty(t, d.Fixed)
+	assert.Empty(t, d.Changed)
+}
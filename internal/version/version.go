@@ -19,20 +19,24 @@ var (
 
 // Info returns version and build information.
 func Info() string {
+	return fmt.Sprintf(
+		"(Version=\"%s\", GitCommit=\"%s\", CommitDate=\"%s\", GoVersion=\"%s\", Compiler=\"%s\", Platform=\"%s\")",
+		Version, GitCommit, CommitDate(), GoVersion, Compiler, Platform,
+	)
+}
+
+// CommitDate returns the date of the built commit, or an empty string if it is unknown.
+func CommitDate() string {
 	i, err := strconv.ParseInt(SourceDateEpoch, 10, 64) //nolint:gomnd
 	if err != nil {
 		panic(err)
 	}
-	commitDate := ""
-	if i >= 0 {
-		// https://pkg.go.dev/time#Time.Format
-		//
-		//     $ TZ=MST date -Iseconds -d"Jan 2 15:04:05 2006 MST"
-		//     2006-01-02T15:04:05-07:00
-		commitDate = time.Unix(i, 0).UTC().Format("2006-01-02T15:04:05-07:00")
+	if i < 0 {
+		return ""
 	}
-	return fmt.Sprintf(
-		"(Version=\"%s\", GitCommit=\"%s\", CommitDate=\"%s\", GoVersion=\"%s\", Compiler=\"%s\", Platform=\"%s\")",
-		Version, GitCommit, commitDate, GoVersion, Compiler, Platform,
-	)
+	// https://pkg.go.dev/time#Time.Format
+	//
+	//     $ TZ=MST date -Iseconds -d"Jan 2 15:04:05 2006 MST"
+	//     2006-01-02T15:04:05-07:00
+	return time.Unix(i, 0).UTC().Format("2006-01-02T15:04:05-07:00")
 }
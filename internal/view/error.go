@@ -0,0 +1,102 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+// ErrorDetails is a detailed view of an error and its latest event.
+type ErrorDetails struct {
+	Error  *bugsnag.Error
+	Event  *bugsnag.Event
+	Frames int
+}
+
+// Render writes error summary followed by the stacktrace of the latest event to w.
+func (e ErrorDetails) Render(w io.Writer) error {
+	bold := color.New(color.Bold)
+
+	fmt.Fprintf(w, "%s: %s\n\n", bold.Sprint(e.Error.ErrorClass), e.Error.Message)
+
+	if err := e.details().Render(w); err != nil {
+		return err
+	}
+
+	if e.Event == nil || len(e.Event.Exceptions) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(
+		w, "\n%s (event %s, %s)\n",
+		bold.Sprint("STACKTRACE"), e.Event.ID, cmdutil.FormatDateTimeHuman(e.Event.ReceivedAt, bugsnag.ISO8601),
+	)
+	for i, ex := range e.Event.Exceptions {
+		if i > 0 {
+			fmt.Fprintf(w, "\nCaused by %s: %s\n", bold.Sprint(ex.ErrorClass), ex.Message)
+		}
+		RenderStacktrace(w, ex.Stacktrace, e.Frames)
+	}
+
+	return nil
+}
+
+// Table returns error summary as a single row table.
+func (e ErrorDetails) Table() *Table {
+	d := append(Details{
+		{Label: "Class", Value: e.Error.ErrorClass},
+		{Label: "Message", Value: e.Error.Message},
+	}, e.details()...)
+
+	return d.Table()
+}
+
+func (e ErrorDetails) details() Details {
+	return Details{
+		{Label: "ID", Value: e.Error.ID},
+		{Label: "Context", Value: e.Error.Context},
+		{Label: "Severity", Value: e.Error.Severity},
+		{Label: "Status", Value: e.Error.Status},
+		{Label: "Events", Value: strconv.Itoa(e.Error.Events)},
+		{Label: "Users", Value: strconv.Itoa(e.Error.Users)},
+		{Label: "First seen", Value: cmdutil.FormatDateTimeHuman(e.Error.FirstSeen, bugsnag.ISO8601)},
+		{Label: "Last seen", Value: cmdutil.FormatDateTimeHuman(e.Error.LastSeen, bugsnag.ISO8601)},
+		{Label: "Release stages", Value: strings.Join(e.Error.ReleaseStages, ", ")},
+		{Label: "Comments", Value: strconv.Itoa(e.Error.CommentCount)},
+	}
+}
+
+// RenderStacktrace writes up to max frames of the stacktrace to w. In project
+// frames are highlighted. All frames are written if max is less than one.
+func RenderStacktrace(w io.Writer, frames []*bugsnag.StackFrame, max int) {
+	inProject := color.New(color.FgGreen, color.Bold)
+	external := color.New(color.Faint)
+
+	for i, f := range frames {
+		if max > 0 && i >= max {
+			fmt.Fprintf(w, "  ... %d more frames\n", len(frames)-max)
+			break
+		}
+
+		loc := f.File
+		if f.LineNumber > 0 {
+			loc += ":" + strconv.Itoa(f.LineNumber)
+		}
+		if f.ColumnNumber > 0 {
+			loc += ":" + strconv.Itoa(f.ColumnNumber)
+		}
+
+		line := fmt.Sprintf("  at %s (%s)", OrBlank(f.Method), loc)
+		if f.InProject {
+			fmt.Fprintln(w, inProject.Sprint(line))
+		} else {
+			fmt.Fprintln(w, external.Sprint(line))
+		}
+	}
+}
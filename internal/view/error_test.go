@@ -0,0 +1,87 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+func TestRenderStacktrace(t *testing.T) {
+	color.NoColor = true
+
+	frames := []*bugsnag.StackFrame{
+		{File: "app.js", Method: "pay", LineNumber: 10, ColumnNumber: 4, InProject: true},
+		{File: "cart.js", Method: "checkout", LineNumber: 52},
+		{File: "vendor.js", LineNumber: 200},
+	}
+
+	cases := []struct {
+		name     string
+		max      int
+		expected string
+	}{
+		{
+			name: "it writes all frames without a limit",
+			max:  0,
+			expected: "  at pay (app.js:10:4)\n" +
+				"  at checkout (cart.js:52)\n" +
+				"  at - (vendor.js:200)\n",
+		},
+		{
+			name: "it truncates frames over the limit",
+			max:  2,
+			expected: "  at pay (app.js:10:4)\n" +
+				"  at checkout (cart.js:52)\n" +
+				"  ... 1 more frames\n",
+		},
+		{
+			name: "it writes all frames at the limit",
+			max:  3,
+			expected: "  at pay (app.js:10:4)\n" +
+				"  at checkout (cart.js:52)\n" +
+				"  at - (vendor.js:200)\n",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			RenderStacktrace(&buf, frames, tc.max)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestErrorDetailsRender(t *testing.T) {
+	color.NoColor = true
+
+	details := ErrorDetails{
+		Error: &bugsnag.Error{ID: "e1", ErrorClass: "TypeError", Message: "x is undefined", Events: 3},
+		Event: &bugsnag.Event{
+			ID: "ev1",
+			Exceptions: []*bugsnag.Exception{
+				{ErrorClass: "TypeError", Stacktrace: []*bugsnag.StackFrame{{File: "a.js"}, {File: "b.js"}}},
+				{ErrorClass: "NetworkError", Message: "timeout", Stacktrace: []*bugsnag.StackFrame{{File: "c.js"}}},
+			},
+		},
+		Frames: 1,
+	}
+
+	var buf bytes.Buffer
+
+	assert.NoError(t, details.Render(&buf))
+
+	out := buf.String()
+	assert.Contains(t, out, "TypeError: x is undefined\n")
+	assert.Contains(t, out, "STACKTRACE (event ev1")
+	assert.Contains(t, out, "  at - (a.js)\n  ... 1 more frames\n")
+	assert.Contains(t, out, "Caused by NetworkError: timeout\n  at - (c.js)\n")
+	assert.NotContains(t, out, "b.js")
+}
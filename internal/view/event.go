@@ -0,0 +1,340 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/cmdutil"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+// Sections of an event that can be viewed on their own.
+const (
+	SectionExceptions  = "exceptions"
+	SectionThreads     = "threads"
+	SectionBreadcrumbs = "breadcrumbs"
+	SectionRequest     = "request"
+	SectionUser        = "user"
+	SectionDevice      = "device"
+	SectionApp         = "app"
+	SectionMetaData    = "metadata"
+)
+
+const maxBreadcrumbMetaLength = 80
+
+// EventSections lists event sections in the order they are rendered.
+var EventSections = []string{
+	SectionExceptions,
+	SectionThreads,
+	SectionBreadcrumbs,
+	SectionRequest,
+	SectionUser,
+	SectionDevice,
+	SectionApp,
+	SectionMetaData,
+}
+
+// EventDetails is a detailed view of an event.
+type EventDetails struct {
+	Event  *bugsnag.Event
+	Frames int
+	// Section limits the view to a single section, all sections are shown if empty.
+	Section string
+}
+
+// Render writes event summary followed by all non empty sections to w.
+func (e EventDetails) Render(w io.Writer) error {
+	bold := color.New(color.Bold)
+
+	if e.Section != "" {
+		return e.renderSection(w, e.Section)
+	}
+
+	if ex := e.Event.Exception(); ex != nil {
+		fmt.Fprintf(w, "%s: %s\n\n", bold.Sprint(ex.ErrorClass), ex.Message)
+	}
+	if err := e.details().Render(w); err != nil {
+		return err
+	}
+
+	for _, s := range EventSections {
+		if e.isEmpty(s) {
+			continue
+		}
+		fmt.Fprintf(w, "\n%s\n", bold.Sprint(strings.ToUpper(s)))
+		if err := e.renderSection(w, s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Table returns the selected section, or the event summary, as a table.
+func (e EventDetails) Table() *Table {
+	ev := e.Event
+
+	switch e.Section {
+	case SectionExceptions:
+		t := Table{Header: []string{"CLASS", "MESSAGE", "TYPE", "FRAMES"}}
+		for _, ex := range ev.Exceptions {
+			t.Rows = append(t.Rows, []string{ex.ErrorClass, ex.Message, ex.Type, strconv.Itoa(len(ex.Stacktrace))})
+		}
+		return &t
+	case SectionThreads:
+		t := Table{Header: []string{"ID", "NAME", "TYPE", "ERROR REPORTING", "FRAMES"}}
+		for _, th := range ev.Threads {
+			t.Rows = append(t.Rows, []string{
+				th.ID, th.Name, th.Type, strconv.FormatBool(th.ErrorReportingThread), strconv.Itoa(len(th.Stacktrace)),
+			})
+		}
+		return &t
+	case SectionBreadcrumbs:
+		t := Table{Header: []string{"TIMESTAMP", "TYPE", "NAME", "METADATA"}}
+		for _, b := range ev.Breadcrumbs {
+			t.Rows = append(t.Rows, []string{b.Timestamp, b.Type, b.Name, breadcrumbMeta(b, 0)})
+		}
+		return &t
+	case "":
+		d := append(Details{}, e.details()...)
+		if ex := ev.Exception(); ex != nil {
+			d = append(Details{{Label: "Class", Value: ex.ErrorClass}, {Label: "Message", Value: ex.Message}}, d...)
+		}
+		return d.Table()
+	}
+
+	t := Table{Header: []string{"KEY", "VALUE"}}
+	values := flatten(e.Data())
+	for _, k := range sortedKeys(values) {
+		t.Rows = append(t.Rows, []string{k, values[k]})
+	}
+	return &t
+}
+
+// Data returns raw data of the selected section, or the whole event if no section is selected.
+// The raw api payload is used when available so that fields unknown to the client are kept.
+func (e EventDetails) Data() interface{} {
+	ev := e.Event
+
+	if ev.Raw != nil {
+		return e.rawData()
+	}
+
+	switch e.Section {
+	case SectionExceptions:
+		return ev.Exceptions
+	case SectionThreads:
+		return ev.Threads
+	case SectionBreadcrumbs:
+		return ev.Breadcrumbs
+	case SectionRequest:
+		return ev.Request
+	case SectionUser:
+		return ev.User
+	case SectionDevice:
+		return ev.Device
+	case SectionApp:
+		return ev.App
+	case SectionMetaData:
+		return ev.MetaData
+	}
+	return ev
+}
+
+func (e EventDetails) rawData() interface{} {
+	if e.Section == "" {
+		return e.Event.Raw
+	}
+
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(e.Event.Raw, &sections); err != nil {
+		return nil
+	}
+
+	key := e.Section
+	if key == SectionMetaData {
+		key = "metaData"
+	}
+	if raw, ok := sections[key]; ok {
+		return raw
+	}
+	return nil
+}
+
+func (e EventDetails) details() Details {
+	ev := e.Event
+
+	return Details{
+		{Label: "ID", Value: ev.ID},
+		{Label: "Error ID", Value: ev.ErrorID},
+		{Label: "Context", Value: ev.Context},
+		{Label: "Severity", Value: ev.Severity},
+		{Label: "Unhandled", Value: strconv.FormatBool(ev.Unhandled)},
+		{Label: "Received", Value: cmdutil.FormatDateTimeHuman(ev.ReceivedAt, bugsnag.ISO8601)},
+	}
+}
+
+func (e EventDetails) isEmpty(section string) bool {
+	ev := e.Event
+
+	switch section {
+	case SectionExceptions:
+		return len(ev.Exceptions) == 0
+	case SectionThreads:
+		return len(ev.Threads) == 0
+	case SectionBreadcrumbs:
+		return len(ev.Breadcrumbs) == 0
+	case SectionRequest:
+		return ev.Request == nil
+	case SectionUser:
+		return ev.User == nil
+	case SectionDevice:
+		return len(ev.Device) == 0
+	case SectionApp:
+		return len(ev.App) == 0
+	case SectionMetaData:
+		return len(ev.MetaData) == 0
+	}
+	return true
+}
+
+func (e EventDetails) renderSection(w io.Writer, section string) error {
+	bold := color.New(color.Bold)
+	ev := e.Event
+
+	if e.isEmpty(section) {
+		fmt.Fprintf(w, "No %s captured in this event.\n", section)
+		return nil
+	}
+
+	switch section {
+	case SectionExceptions:
+		for i, ex := range ev.Exceptions {
+			if i > 0 {
+				fmt.Fprintf(w, "\nCaused by %s: %s\n", bold.Sprint(ex.ErrorClass), ex.Message)
+			} else {
+				fmt.Fprintf(w, "%s: %s\n", bold.Sprint(ex.ErrorClass), ex.Message)
+			}
+			RenderStacktrace(w, ex.Stacktrace, e.Frames)
+		}
+	case SectionThreads:
+		for i, th := range ev.Threads {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			name := fmt.Sprintf("Thread %s %s", th.ID, th.Name)
+			if th.ErrorReportingThread {
+				name += " (error reporting)"
+			}
+			fmt.Fprintln(w, bold.Sprint(strings.TrimSpace(name)))
+			RenderStacktrace(w, th.Stacktrace, e.Frames)
+		}
+	case SectionBreadcrumbs:
+		tw := tabwriter.NewWriter(w, 0, tabWidth, cellPadding, ' ', 0)
+		for _, b := range ev.Breadcrumbs {
+			fmt.Fprintf(
+				tw, "%s\t%s\t%s\t%s\n",
+				b.Timestamp, b.Type, b.Name, breadcrumbMeta(b, maxBreadcrumbMetaLength),
+			)
+		}
+		return tw.Flush()
+	case SectionMetaData:
+		for i, tab := range sortedKeys(ev.MetaData) {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "[%s]\n", tab)
+			if err := renderValues(w, flatten(ev.MetaData[tab])); err != nil {
+				return err
+			}
+		}
+	default:
+		return renderValues(w, flatten(e.withSection(section).Data()))
+	}
+
+	return nil
+}
+
+func (e EventDetails) withSection(section string) EventDetails {
+	e.Section = section
+	return e
+}
+
+func renderValues(w io.Writer, values map[string]string) error {
+	tw := tabwriter.NewWriter(w, 0, tabWidth, cellPadding, ' ', 0)
+	for _, k := range sortedKeys(values) {
+		fmt.Fprintf(tw, "%s:\t%s\n", k, OrBlank(values[k]))
+	}
+	return tw.Flush()
+}
+
+func breadcrumbMeta(b *bugsnag.Breadcrumb, max int) string {
+	values := flatten(b.MetaData)
+
+	pairs := make([]string, 0, len(values))
+	for _, k := range sortedKeys(values) {
+		pairs = append(pairs, k+"="+values[k])
+	}
+
+	s := strings.Join(pairs, ", ")
+	if max > 0 {
+		s = Shorten(s, max)
+	}
+	return OrBlank(s)
+}
+
+// flatten converts nested values to a flat map with dot separated keys.
+func flatten(v interface{}) map[string]string {
+	out := make(map[string]string)
+
+	// Structs are flattened through their json representation.
+	var generic interface{}
+	if b, err := json.Marshal(v); err == nil {
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.UseNumber()
+		_ = dec.Decode(&generic)
+	}
+
+	var walk func(prefix string, v interface{})
+	walk = func(prefix string, v interface{}) {
+		switch val := v.(type) {
+		case map[string]interface{}:
+			for k, item := range val {
+				key := k
+				if prefix != "" {
+					key = prefix + "." + k
+				}
+				walk(key, item)
+			}
+		case []interface{}:
+			b, _ := json.Marshal(val)
+			out[prefix] = string(b)
+		case nil:
+			if prefix != "" {
+				out[prefix] = ""
+			}
+		default:
+			out[prefix] = fmt.Sprint(val)
+		}
+	}
+	walk("", generic)
+
+	return out
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,205 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+func TestFlatten(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    interface{}
+		expected map[string]string
+	}{
+		{
+			name:     "it flattens nested maps with dot separated keys",
+			input:    map[string]interface{}{"a": map[string]interface{}{"b": 1, "c": map[string]interface{}{"d": "x"}}},
+			expected: map[string]string{"a.b": "1", "a.c.d": "x"},
+		},
+		{
+			name:     "it keeps lists as json",
+			input:    map[string]interface{}{"tags": []string{"a", "b"}},
+			expected: map[string]string{"tags": `["a","b"]`},
+		},
+		{
+			name:     "it keeps nulls as empty values",
+			input:    map[string]interface{}{"a": nil},
+			expected: map[string]string{"a": ""},
+		},
+		{
+			name:     "it keeps large numbers intact",
+			input:    json.RawMessage(`{"total": 12345678901234567890, "ratio": 0.5}`),
+			expected: map[string]string{"total": "12345678901234567890", "ratio": "0.5"},
+		},
+		{
+			name:     "it flattens structs through their json fields",
+			input:    &bugsnag.EventUser{ID: "42", Email: "u@example.com"},
+			expected: map[string]string{"id": "42", "name": "", "email": "u@example.com"},
+		},
+		{
+			name:     "it returns an empty map for nil",
+			input:    nil,
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, flatten(tc.input))
+		})
+	}
+}
+
+func TestEventDetailsData(t *testing.T) {
+	typed := &bugsnag.Event{
+		ID:       "ev1",
+		User:     &bugsnag.EventUser{ID: "42"},
+		MetaData: map[string]interface{}{"cart": map[string]interface{}{"items": 3}},
+	}
+	raw := &bugsnag.Event{
+		ID:  "ev1",
+		Raw: json.RawMessage(`{"id": "ev1", "user": {"id": "42", "ip": "1.2.3.4"}, "metaData": {"cart": {"items": 3}}, "custom": true}`),
+	}
+
+	cases := []struct {
+		name     string
+		event    *bugsnag.Event
+		section  string
+		expected string
+	}{
+		{
+			name:     "it returns a section of the typed event",
+			event:    typed,
+			section:  SectionUser,
+			expected: `{"id": "42", "name": "", "email": ""}`,
+		},
+		{
+			name:     "it keeps unknown fields of the raw event",
+			event:    raw,
+			expected: `{"id": "ev1", "user": {"id": "42", "ip": "1.2.3.4"}, "metaData": {"cart": {"items": 3}}, "custom": true}`,
+		},
+		{
+			name:     "it keeps unknown fields of a raw section",
+			event:    raw,
+			section:  SectionUser,
+			expected: `{"id": "42", "ip": "1.2.3.4"}`,
+		},
+		{
+			name:     "it maps the metadata section to its api field",
+			event:    raw,
+			section:  SectionMetaData,
+			expected: `{"cart": {"items": 3}}`,
+		},
+		{
+			name:     "it returns null for a section missing from the raw event",
+			event:    raw,
+			section:  SectionDevice,
+			expected: `null`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			details := EventDetails{Event: tc.event, Section: tc.section}
+
+			actual, err := json.Marshal(details.Data())
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(actual))
+		})
+	}
+}
+
+func TestEventDetailsRender(t *testing.T) {
+	color.NoColor = true
+
+	event := &bugsnag.Event{
+		ID:        "ev1",
+		ErrorID:   "e1",
+		Severity:  "error",
+		Unhandled: true,
+		Exceptions: []*bugsnag.Exception{
+			{ErrorClass: "TypeError", Message: "x is undefined", Stacktrace: []*bugsnag.StackFrame{{File: "a.js"}, {File: "b.js"}}},
+			{ErrorClass: "NetworkError", Message: "timeout", Stacktrace: []*bugsnag.StackFrame{{File: "c.js"}}},
+		},
+		Breadcrumbs: []*bugsnag.Breadcrumb{
+			{Timestamp: "2022-07-01T10:00:00Z", Type: "navigation", Name: "Page loaded", MetaData: map[string]interface{}{"to": "/cart"}},
+		},
+		User:     &bugsnag.EventUser{ID: "42", Email: "u@example.com"},
+		MetaData: map[string]interface{}{"cart": map[string]interface{}{"items": 3}, "app": map[string]interface{}{"flag": true}},
+	}
+
+	cases := []struct {
+		name        string
+		section     string
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:    "it renders the summary and non empty sections",
+			section: "",
+			contains: []string{
+				"TypeError: x is undefined\n\n",
+				"\nEXCEPTIONS\nTypeError: x is undefined\n  at - (a.js)\n  ... 1 more frames\n",
+				"\nCaused by NetworkError: timeout\n  at - (c.js)\n",
+				"\nBREADCRUMBS\n",
+				"\nUSER\n",
+				"\nMETADATA\n",
+			},
+			notContains: []string{"THREADS", "REQUEST", "DEVICE", "b.js"},
+		},
+		{
+			name:        "it renders a single section",
+			section:     SectionBreadcrumbs,
+			contains:    []string{"2022-07-01T10:00:00Z  navigation  Page loaded  to=/cart\n"},
+			notContains: []string{"TypeError", "BREADCRUMBS"},
+		},
+		{
+			name:     "it renders metadata tabs in order",
+			section:  SectionMetaData,
+			contains: []string{"[app]\nflag:  true\n\n[cart]\nitems:  3\n"},
+		},
+		{
+			name:     "it renders values of a section",
+			section:  SectionUser,
+			contains: []string{"email:  u@example.com\n", "id:     42\n", "name:   -\n"},
+		},
+		{
+			name:     "it notes empty sections",
+			section:  SectionThreads,
+			contains: []string{"No threads captured in this event.\n"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			details := EventDetails{Event: event, Frames: 1, Section: tc.section}
+			assert.NoError(t, details.Render(&buf))
+
+			out := buf.String()
+			for _, s := range tc.contains {
+				assert.Contains(t, out, s)
+			}
+			for _, s := range tc.notContains {
+				assert.NotContains(t, out, s)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+const pivotBarWidth = 30
+
+// PivotValues is a view of the distribution of an event field.
+type PivotValues struct {
+	Values []*bugsnag.PivotValue
+	// Total is the number of events percentages are relative to.
+	Total int
+}
+
+// Render writes values with their share of events as bars to w.
+func (p PivotValues) Render(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, tabWidth, cellPadding, ' ', 0)
+
+	fmt.Fprintln(tw, "VALUE\tEVENTS\tPERCENT")
+	for _, v := range p.Values {
+		fmt.Fprintf(
+			tw, "%s\t%d\t%s\t%s\n",
+			OrBlank(v.Value), v.Events, p.percent(v), Bar(v.Events, p.Total, pivotBarWidth),
+		)
+	}
+
+	return tw.Flush()
+}
+
+// Table returns one row per value.
+func (p PivotValues) Table() *Table {
+	t := Table{Header: []string{"VALUE", "EVENTS", "PERCENT"}}
+	for _, v := range p.Values {
+		t.Rows = append(t.Rows, []string{v.Value, strconv.Itoa(v.Events), p.percent(v)})
+	}
+	return &t
+}
+
+func (p PivotValues) percent(v *bugsnag.PivotValue) string {
+	if p.Total <= 0 {
+		return Blank
+	}
+	return fmt.Sprintf("%.1f%%", float64(v.Events)*100/float64(p.Total))
+}
@@ -0,0 +1,162 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/fatih/color"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/release"
+)
+
+const diffMessageLength = 60
+
+// Statuses of an error in a release diff.
+const (
+	DiffNew       = "new"
+	DiffRegressed = "regressed"
+	DiffFixed     = "fixed"
+	DiffChanged   = "changed"
+)
+
+// ReleaseDiff is a view of errors that changed between two releases.
+type ReleaseDiff struct {
+	Diff *release.Diff
+	// Stage is the release stage both releases were compared in, if any.
+	Stage string
+}
+
+type diffSection struct {
+	title   string
+	changes []*release.Change
+	table   func([]*release.Change) *Table
+}
+
+// Render writes one table per section to w.
+func (r ReleaseDiff) Render(w io.Writer) error {
+	bold := color.New(color.Bold)
+
+	fmt.Fprintln(w, r.title())
+	for _, s := range r.sections() {
+		fmt.Fprintf(w, "\n%s (%d)\n", bold.Sprint(s.title), len(s.changes))
+		if len(s.changes) == 0 {
+			fmt.Fprintln(w, "None.")
+			continue
+		}
+		if err := s.table(s.changes).Render(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Markdown writes one markdown table per section to w, ready to be pasted in release notes.
+func (r ReleaseDiff) Markdown(w io.Writer) error {
+	fmt.Fprintf(w, "## %s\n", r.title())
+	for _, s := range r.sections() {
+		fmt.Fprintf(w, "\n### %s (%d)\n\n", s.title, len(s.changes))
+		if len(s.changes) == 0 {
+			fmt.Fprintln(w, "_None_")
+			continue
+		}
+		if err := s.table(s.changes).Markdown(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Table returns all errors in a single table with their status.
+func (r ReleaseDiff) Table() *Table {
+	t := Table{Header: []string{"STATUS", "ID", "CLASS", "MESSAGE", "FROM EVENTS", "TO EVENTS", "DELTA"}}
+
+	add := func(status string, changes []*release.Change) {
+		for _, c := range changes {
+			t.Rows = append(t.Rows, []string{
+				status,
+				c.Error.ID,
+				c.Error.ErrorClass,
+				c.Error.Message,
+				strconv.Itoa(c.EventsFrom),
+				strconv.Itoa(c.EventsTo),
+				formatDelta(c.Delta()),
+			})
+		}
+	}
+	add(DiffNew, r.Diff.New)
+	add(DiffRegressed, r.Diff.Regressed)
+	add(DiffFixed, r.Diff.Fixed)
+	add(DiffChanged, r.Diff.Changed)
+
+	return &t
+}
+
+func (r ReleaseDiff) title() string {
+	title := fmt.Sprintf("Release %s compared to %s", r.Diff.To, r.Diff.From)
+	if r.Stage != "" {
+		title += " in " + r.Stage
+	}
+	return title
+}
+
+func (r ReleaseDiff) sections() []diffSection {
+	d := r.Diff
+
+	return []diffSection{
+		{title: "New in " + d.To, changes: d.New, table: latestErrorsTable},
+		{title: "Regressed in " + d.To, changes: d.Regressed, table: latestErrorsTable},
+		{title: "Fixed since " + d.From, changes: d.Fixed, table: earlierErrorsTable},
+		{title: "Event deltas", changes: d.Changed, table: r.deltaTable},
+	}
+}
+
+func latestErrorsTable(changes []*release.Change) *Table {
+	return errorsTable(changes, false)
+}
+
+func earlierErrorsTable(changes []*release.Change) *Table {
+	return errorsTable(changes, true)
+}
+
+// errorsTable lists errors with their events and users in the later release,
+// or in the earlier one if from is set.
+func errorsTable(changes []*release.Change, from bool) *Table {
+	t := Table{Header: []string{"ID", "CLASS", "MESSAGE", "EVENTS", "USERS"}}
+	for _, c := range changes {
+		events, users := c.EventsTo, c.UsersTo
+		if from {
+			events, users = c.EventsFrom, c.UsersFrom
+		}
+		t.Rows = append(t.Rows, []string{
+			c.Error.ID,
+			c.Error.ErrorClass,
+			Shorten(c.Error.Message, diffMessageLength),
+			strconv.Itoa(events),
+			strconv.Itoa(users),
+		})
+	}
+	return &t
+}
+
+func (r ReleaseDiff) deltaTable(changes []*release.Change) *Table {
+	t := Table{Header: []string{"ID", "CLASS", "MESSAGE", r.Diff.From, r.Diff.To, "DELTA"}}
+	for _, c := range changes {
+		t.Rows = append(t.Rows, []string{
+			c.Error.ID,
+			c.Error.ErrorClass,
+			Shorten(c.Error.Message, diffMessageLength),
+			strconv.Itoa(c.EventsFrom),
+			strconv.Itoa(c.EventsTo),
+			formatDelta(c.Delta()),
+		})
+	}
+	return &t
+}
+
+func formatDelta(n int) string {
+	if n > 0 {
+		return "+" + strconv.Itoa(n)
+	}
+	return strconv.Itoa(n)
+}
@@ -0,0 +1,83 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/teamupstart/bugsnag-data-cli/internal/release"
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+func TestReleaseDiffMarkdown(t *testing.T) {
+	diff := &release.Diff{
+		From: "2.4.0",
+		To:   "2.4.1",
+		New: []*release.Change{
+			{Error: &bugsnag.Error{ID: "a", ErrorClass: "TypeError", Message: "x | y is\nundefined"}, EventsTo: 7, UsersTo: 2},
+		},
+		Fixed: []*release.Change{
+			{Error: &bugsnag.Error{ID: "b", ErrorClass: "NetworkError", Message: "timeout"}, EventsFrom: 5, UsersFrom: 1},
+		},
+		Changed: []*release.Change{
+			{Error: &bugsnag.Error{ID: "c", ErrorClass: "RangeError", Message: "too deep"}, EventsFrom: 10, EventsTo: 4},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		stage    string
+		expected string
+	}{
+		{
+			name:  "it writes one table per section",
+			stage: "",
+			expected: "## Release 2.4.1 compared to 2.4.0\n" +
+				"\n### New in 2.4.1 (1)\n\n" +
+				"| ID | CLASS | MESSAGE | EVENTS | USERS |\n" +
+				"| --- | --- | --- | --- | --- |\n" +
+				"| a | TypeError | x \\| y is undefined | 7 | 2 |\n" +
+				"\n### Regressed in 2.4.1 (0)\n\n" +
+				"_None_\n" +
+				"\n### Fixed since 2.4.0 (1)\n\n" +
+				"| ID | CLASS | MESSAGE | EVENTS | USERS |\n" +
+				"| --- | --- | --- | --- | --- |\n" +
+				"| b | NetworkError | timeout | 5 | 1 |\n" +
+				"\n### Event deltas (1)\n\n" +
+				"| ID | CLASS | MESSAGE | 2.4.0 | 2.4.1 | DELTA |\n" +
+				"| --- | --- | --- | --- | --- | --- |\n" +
+				"| c | RangeError | too deep | 10 | 4 | -6 |\n",
+		},
+		{
+			name:  "it names the release stage in the title",
+			stage: "production",
+			expected: "## Release 2.4.1 compared to 2.4.0 in production\n" +
+				"\n### New in 2.4.1 (1)\n\n" +
+				"| ID | CLASS | MESSAGE | EVENTS | USERS |\n" +
+				"| --- | --- | --- | --- | --- |\n" +
+				"| a | TypeError | x \\| y is undefined | 7 | 2 |\n" +
+				"\n### Regressed in 2.4.1 (0)\n\n" +
+				"_None_\n" +
+				"\n### Fixed since 2.4.0 (1)\n\n" +
+				"| ID | CLASS | MESSAGE | EVENTS | USERS |\n" +
+				"| --- | --- | --- | --- | --- |\n" +
+				"| b | NetworkError | timeout | 5 | 1 |\n" +
+				"\n### Event deltas (1)\n\n" +
+				"| ID | CLASS | MESSAGE | 2.4.0 | 2.4.1 | DELTA |\n" +
+				"| --- | --- | --- | --- | --- | --- |\n" +
+				"| c | RangeError | too deep | 10 | 4 | -6 |\n",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			assert.NoError(t, ReleaseDiff{Diff: diff, Stage: tc.stage}.Markdown(&buf))
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+// Stability is a view of a project's daily stability against its targets.
+type Stability struct {
+	Trend   *bugsnag.StabilityTrend
+	Project *bugsnag.Project
+}
+
+// Render writes targets followed by one line per time bucket to w.
+func (s Stability) Render(w io.Writer) error {
+	bold := color.New(color.Bold)
+
+	fmt.Fprintf(w, "%s stability of %s in %s\n", bold.Sprint(s.metric()), s.Project.Name, s.Trend.ReleaseStageName)
+	if err := s.targets().Render(w); err != nil {
+		return err
+	}
+	fmt.Fprintln(w)
+
+	tw := tabwriter.NewWriter(w, 0, tabWidth, cellPadding, ' ', 0)
+	fmt.Fprintln(tw, "FROM\tTO\tSESSIONS\tUSERS\tSTABILITY\tSTATUS")
+	for _, p := range s.Trend.TimelinePoints {
+		fmt.Fprintf(
+			tw, "%s\t%s\t%d\t%d\t%s\t%s\n",
+			formatBucketTime(p.BucketStart), formatBucketTime(p.BucketEnd),
+			p.TotalSessionsCount, p.UsersSeen, Percent(s.stability(p)), s.status(p, true),
+		)
+	}
+	return tw.Flush()
+}
+
+// Table returns one row per time bucket.
+func (s Stability) Table() *Table {
+	t := Table{Header: []string{"FROM", "TO", "SESSIONS", "USERS", "STABILITY", "STATUS"}}
+	for _, p := range s.Trend.TimelinePoints {
+		t.Rows = append(t.Rows, []string{
+			p.BucketStart,
+			p.BucketEnd,
+			strconv.Itoa(p.TotalSessionsCount),
+			strconv.Itoa(p.UsersSeen),
+			Percent(s.stability(p)),
+			s.status(p, false),
+		})
+	}
+	return &t
+}
+
+// Data returns the trend along with the project's targets.
+func (s Stability) Data() interface{} {
+	return struct {
+		*bugsnag.StabilityTrend
+		StabilityTargetType string                   `json:"stability_target_type"`
+		TargetStability     *bugsnag.StabilityTarget `json:"target_stability"`
+		CriticalStability   *bugsnag.StabilityTarget `json:"critical_stability"`
+	}{s.Trend, s.targetType(), s.Project.TargetStability, s.Project.CriticalStability}
+}
+
+func (s Stability) targets() Details {
+	target, critical := Blank, Blank
+	if t := s.Project.TargetStability; t != nil {
+		target = Percent(t.Value, true)
+	}
+	if c := s.Project.CriticalStability; c != nil {
+		critical = Percent(c.Value, true)
+	}
+	return Details{
+		{Label: "Target", Value: target},
+		{Label: "Critical", Value: critical},
+	}
+}
+
+func (s Stability) targetType() string {
+	if s.Project.StabilityTargetType == bugsnag.StabilityTargetSession {
+		return bugsnag.StabilityTargetSession
+	}
+	return bugsnag.StabilityTargetUser
+}
+
+func (s Stability) metric() string {
+	if s.targetType() == bugsnag.StabilityTargetSession {
+		return "Session"
+	}
+	return "User"
+}
+
+func (s Stability) stability(p *bugsnag.StabilityTimePoint) (float64, bool) {
+	if s.targetType() == bugsnag.StabilityTargetSession {
+		return p.CrashFreeSessions()
+	}
+	return p.CrashFreeUsers()
+}
+
+func (s Stability) status(p *bugsnag.StabilityTimePoint, colored bool) string {
+	v, ok := s.stability(p)
+	if !ok {
+		return Blank
+	}
+
+	var (
+		status string
+		c      = color.New(color.FgGreen)
+	)
+	switch {
+	case s.Project.CriticalStability != nil && v < s.Project.CriticalStability.Value:
+		status, c = "critical", color.New(color.FgRed)
+	case s.Project.TargetStability != nil && v < s.Project.TargetStability.Value:
+		status, c = "below target", color.New(color.FgYellow)
+	case s.Project.TargetStability != nil:
+		status = "on target"
+	default:
+		return Blank
+	}
+
+	if !colored {
+		return status
+	}
+	return c.Sprint(status)
+}
+
+// Percent formats a fraction between 0 and 1 as a percentage, or Blank if ok is false.
+func Percent(v float64, ok bool) string {
+	if !ok {
+		return Blank
+	}
+	return strconv.FormatFloat(v*100, 'f', 2, 64) + "%"
+}
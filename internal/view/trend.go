@@ -0,0 +1,131 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+// Chart styles of a trend view.
+const (
+	ChartNone      = ""
+	ChartBars      = "bars"
+	ChartSparkline = "sparkline"
+)
+
+const (
+	barWidth      = 40
+	trendTimeFmt  = "2006-01-02 15:04"
+	sparkSymbols  = "▁▂▃▄▅▆▇█"
+	barSymbol     = "█"
+	barSymbolZero = "▏"
+)
+
+// Trend is a view of event counts over time.
+type Trend struct {
+	Buckets []*bugsnag.TrendBucket
+	Chart   string
+}
+
+// Render writes the trend as a table, bar chart or sparkline to w.
+func (t Trend) Render(w io.Writer) error {
+	switch t.Chart {
+	case ChartBars:
+		return t.renderBars(w)
+	case ChartSparkline:
+		return t.renderSparkline(w)
+	}
+	return t.Table().Render(w)
+}
+
+// Table returns one row per bucket.
+func (t Trend) Table() *Table {
+	table := Table{Header: []string{"FROM", "TO", "EVENTS"}}
+	for _, b := range t.Buckets {
+		table.Rows = append(table.Rows, []string{b.From, b.To, strconv.Itoa(b.EventsCount)})
+	}
+	return &table
+}
+
+func (t Trend) renderBars(w io.Writer) error {
+	max := t.max()
+
+	tw := tabwriter.NewWriter(w, 0, tabWidth, cellPadding, ' ', tabwriter.AlignRight)
+	for _, b := range t.Buckets {
+		fmt.Fprintf(tw, "%s\t%d\t %s\n", formatBucketTime(b.From), b.EventsCount, Bar(b.EventsCount, max, barWidth))
+	}
+	return tw.Flush()
+}
+
+func (t Trend) renderSparkline(w io.Writer) error {
+	if len(t.Buckets) == 0 {
+		return nil
+	}
+
+	max := t.max()
+	symbols := []rune(sparkSymbols)
+
+	var (
+		line  strings.Builder
+		total int
+		peak  = t.Buckets[0]
+	)
+	for _, b := range t.Buckets {
+		i := 0
+		if max > 0 {
+			i = b.EventsCount * (len(symbols) - 1) / max
+		}
+		line.WriteRune(symbols[i])
+
+		total += b.EventsCount
+		if b.EventsCount > peak.EventsCount {
+			peak = b
+		}
+	}
+
+	first, last := t.Buckets[0], t.Buckets[len(t.Buckets)-1]
+
+	fmt.Fprintf(w, "%s %s %s\n", formatBucketTime(first.From), line.String(), formatBucketTime(last.To))
+	_, err := fmt.Fprintf(
+		w, "%d events, peak of %d at %s\n", total, peak.EventsCount, formatBucketTime(peak.From),
+	)
+	return err
+}
+
+func (t Trend) max() int {
+	max := 0
+	for _, b := range t.Buckets {
+		if b.EventsCount > max {
+			max = b.EventsCount
+		}
+	}
+	return max
+}
+
+// Bar returns a horizontal bar proportional to value, width characters long at max.
+func Bar(value, max, width int) string {
+	if max <= 0 || value <= 0 {
+		return color.New(color.Faint).Sprint(barSymbolZero)
+	}
+
+	n := value * width / max
+	if n == 0 {
+		n = 1
+	}
+	return color.New(color.FgCyan).Sprint(strings.Repeat(barSymbol, n))
+}
+
+func formatBucketTime(s string) string {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return s
+	}
+	return t.UTC().Format(trendTimeFmt)
+}
@@ -0,0 +1,156 @@
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+func TestBar(t *testing.T) {
+	color.NoColor = true
+
+	cases := []struct {
+		name     string
+		value    int
+		max      int
+		width    int
+		expected string
+	}{
+		{name: "it draws a full bar at max", value: 10, max: 10, width: 5, expected: "█████"},
+		{name: "it scales the bar to max", value: 4, max: 10, width: 5, expected: "██"},
+		{name: "it draws at least one block for non zero values", value: 1, max: 1000000, width: 40, expected: "█"},
+		{name: "it draws a zero marker for zero values", value: 0, max: 10, width: 5, expected: "▏"},
+		{name: "it draws a zero marker for a zero max", value: 0, max: 0, width: 5, expected: "▏"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Bar(tc.value, tc.max, tc.width))
+		})
+	}
+}
+
+func TestFormatBucketTime(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "it formats utc times", input: "2022-07-01T10:00:00Z", expected: "2022-07-01 10:00"},
+		{name: "it converts offsets to utc", input: "2022-07-01T12:30:00+02:00", expected: "2022-07-01 10:30"},
+		{name: "it keeps invalid times as is", input: "yesterday", expected: "yesterday"},
+		{name: "it keeps empty times", input: "", expected: ""},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, formatBucketTime(tc.input))
+		})
+	}
+}
+
+func TestTrendRender(t *testing.T) {
+	color.NoColor = true
+
+	bucket := func(from, to string, count int) *bugsnag.TrendBucket {
+		return &bugsnag.TrendBucket{From: from, To: to, EventsCount: count}
+	}
+
+	zeros := []*bugsnag.TrendBucket{
+		bucket("2022-07-01T10:00:00Z", "2022-07-01T11:00:00Z", 0),
+		bucket("2022-07-01T11:00:00Z", "2022-07-01T12:00:00Z", 0),
+	}
+	single := []*bugsnag.TrendBucket{
+		bucket("2022-07-01T10:00:00Z", "2022-07-01T11:00:00Z", 5),
+	}
+	wide := []*bugsnag.TrendBucket{
+		bucket("2022-07-01T10:00:00Z", "2022-07-01T11:00:00Z", 3),
+		bucket("2022-07-01T11:00:00Z", "2022-07-01T12:00:00Z", 1000000),
+		bucket("2022-07-01T12:00:00Z", "2022-07-01T13:00:00Z", 0),
+	}
+
+	cases := []struct {
+		name     string
+		buckets  []*bugsnag.TrendBucket
+		chart    string
+		expected string
+	}{
+		{
+			name:    "it draws zero markers for an all zero series",
+			buckets: zeros,
+			chart:   ChartBars,
+			expected: "  2022-07-01 10:00  0 ▏\n" +
+				"  2022-07-01 11:00  0 ▏\n",
+		},
+		{
+			name:     "it draws a full bar for a single bucket",
+			buckets:  single,
+			chart:    ChartBars,
+			expected: "  2022-07-01 10:00  5 " + strings.Repeat("█", barWidth) + "\n",
+		},
+		{
+			name:    "it aligns very wide counts",
+			buckets: wide,
+			chart:   ChartBars,
+			expected: "  2022-07-01 10:00        3 █\n" +
+				"  2022-07-01 11:00  1000000 " + strings.Repeat("█", barWidth) + "\n" +
+				"  2022-07-01 12:00        0 ▏\n",
+		},
+		{
+			name:    "it draws a flat sparkline for an all zero series",
+			buckets: zeros,
+			chart:   ChartSparkline,
+			expected: "2022-07-01 10:00 ▁▁ 2022-07-01 12:00\n" +
+				"0 events, peak of 0 at 2022-07-01 10:00\n",
+		},
+		{
+			name:    "it draws a single bucket sparkline",
+			buckets: single,
+			chart:   ChartSparkline,
+			expected: "2022-07-01 10:00 █ 2022-07-01 11:00\n" +
+				"5 events, peak of 5 at 2022-07-01 10:00\n",
+		},
+		{
+			name:    "it scales sparklines to very wide counts",
+			buckets: wide,
+			chart:   ChartSparkline,
+			expected: "2022-07-01 10:00 ▁█▁ 2022-07-01 13:00\n" +
+				"1000003 events, peak of 1000000 at 2022-07-01 11:00\n",
+		},
+		{
+			name:     "it draws nothing for an empty sparkline",
+			buckets:  nil,
+			chart:    ChartSparkline,
+			expected: "",
+		},
+		{
+			name:    "it renders a table without a chart",
+			buckets: single,
+			chart:   ChartNone,
+			expected: "FROM                  TO                    EVENTS\n" +
+				"2022-07-01T10:00:00Z  2022-07-01T11:00:00Z  5\n",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			assert.NoError(t, Trend{Buckets: tc.buckets, Chart: tc.chart}.Render(&buf))
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package view
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fatih/color"
+)
+
+// Statuses of an upload.
+const (
+	UploadStatusUploaded = "uploaded"
+	UploadStatusFailed   = "failed"
+	UploadStatusDryRun   = "dry-run"
+)
+
+// UploadResult is the outcome of uploading a single file.
+type UploadResult struct {
+	File   string `json:"file"`
+	Status string `json:"status"`
+	// Target is where the file applies, eg: url of the minified file of a source map.
+	Target string `json:"target,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// UploadResults is a view of uploaded files.
+type UploadResults []*UploadResult
+
+// NewUploadResults pairs files with upload errors. Files are marked
+// as a dry run if errs is nil.
+func NewUploadResults(files, targets []string, errs []error) UploadResults {
+	out := make(UploadResults, 0, len(files))
+	for i, f := range files {
+		r := UploadResult{File: f, Status: UploadStatusDryRun}
+		if i < len(targets) {
+			r.Target = targets[i]
+		}
+		if errs != nil {
+			r.Status = UploadStatusUploaded
+			if errs[i] != nil {
+				r.Status, r.Error = UploadStatusFailed, errs[i].Error()
+			}
+		}
+		out = append(out, &r)
+	}
+	return out
+}
+
+// Failed returns the number of failed uploads.
+func (u UploadResults) Failed() int {
+	n := 0
+	for _, r := range u {
+		if r.Status == UploadStatusFailed {
+			n++
+		}
+	}
+	return n
+}
+
+// Render writes one line per file to w.
+func (u UploadResults) Render(w io.Writer) error {
+	for _, r := range u {
+		var line string
+		switch r.Status {
+		case UploadStatusUploaded:
+			line = color.New(color.FgGreen).Sprint("✓ ") + r.File
+		case UploadStatusFailed:
+			line = color.New(color.FgRed).Sprint("✗ ") + r.File
+		default:
+			line = "- " + r.File
+		}
+		if r.Target != "" {
+			line += color.New(color.Faint).Sprint(" → " + r.Target)
+		}
+		if r.Error != "" {
+			line += ": " + r.Error
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Table returns one row per file.
+func (u UploadResults) Table() *Table {
+	t := Table{Header: []string{"FILE", "TARGET", "STATUS", "ERROR"}}
+	for _, r := range u {
+		t.Rows = append(t.Rows, []string{r.File, r.Target, r.Status, r.Error})
+	}
+	return &t
+}
@@ -0,0 +1,157 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	tabWidth    = 8
+	cellPadding = 2
+	// Blank is shown in place of empty values.
+	Blank = "-"
+)
+
+// Table is a tabular view with a header row.
+type Table struct {
+	Header []string
+	Rows   [][]string
+}
+
+// Render writes tab aligned table to w.
+func (t *Table) Render(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, tabWidth, cellPadding, ' ', 0)
+
+	if len(t.Header) > 0 {
+		fmt.Fprintln(tw, strings.Join(t.Header, "\t"))
+	}
+	for _, row := range t.Rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// Table implements output.Tabular interface.
+func (t *Table) Table() *Table {
+	return t
+}
+
+// Markdown writes the table as a github flavored markdown table to w.
+func (t *Table) Markdown(w io.Writer) error {
+	if len(t.Header) == 0 {
+		return nil
+	}
+
+	sep := make([]string, len(t.Header))
+	for i := range sep {
+		sep[i] = "---"
+	}
+
+	fmt.Fprintf(w, "| %s |\n", strings.Join(markdownCells(t.Header), " | "))
+	fmt.Fprintf(w, "| %s |\n", strings.Join(sep, " | "))
+	for _, row := range t.Rows {
+		if _, err := fmt.Fprintf(w, "| %s |\n", strings.Join(markdownCells(row), " | ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Field is a single label, value pair in a details view.
+type Field struct {
+	Label string
+	Value string
+}
+
+// Details is a key value view of a single resource.
+type Details []Field
+
+// Render writes aligned label, value pairs to w.
+func (d Details) Render(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, tabWidth, cellPadding, ' ', 0)
+
+	for _, f := range d {
+		fmt.Fprintf(tw, "%s:\t%s\n", f.Label, OrBlank(f.Value))
+	}
+
+	return tw.Flush()
+}
+
+// Table returns details as a single row table with labels as the header.
+func (d Details) Table() *Table {
+	t := Table{Rows: [][]string{make([]string, 0, len(d))}}
+	for _, f := range d {
+		t.Header = append(t.Header, strings.ToUpper(f.Label))
+		t.Rows[0] = append(t.Rows[0], f.Value)
+	}
+	return &t
+}
+
+// Value is a view of a single value.
+type Value struct {
+	Name  string
+	Value string
+}
+
+// Render writes the value to w.
+func (v Value) Render(w io.Writer) error {
+	_, err := fmt.Fprintln(w, v.Value)
+	return err
+}
+
+// Table returns the value as a single cell table.
+func (v Value) Table() *Table {
+	return &Table{Header: []string{v.Name}, Rows: [][]string{{v.Value}}}
+}
+
+// JSON writes indented json representation of v to w.
+func JSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+// OrBlank returns Blank if s is empty.
+func OrBlank(s string) string {
+	if strings.TrimSpace(s) == "" {
+		return Blank
+	}
+	return s
+}
+
+// Shorten truncates s to max characters, replacing line breaks with spaces.
+// It returns an empty string if max is less than one.
+func Shorten(s string, max int) string {
+	if max < 1 {
+		return ""
+	}
+	s = strings.Join(strings.Fields(s), " ")
+
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+	return string(r[:max-1]) + "…"
+}
+
+// markdownCells escapes pipes and line breaks that would break a markdown table row.
+func markdownCells(row []string) []string {
+	out := make([]string, len(row))
+	for i, c := range row {
+		out[i] = strings.ReplaceAll(strings.Join(strings.Fields(c), " "), "|", "\\|")
+	}
+	return out
+}
+
+// Footer writes pagination summary to w if not all items are shown.
+func Footer(w io.Writer, shown, total int, noun string) {
+	if total <= shown {
+		return
+	}
+	fmt.Fprintf(w, "\nShowing %d of %d %s. Use --limit to see more.\n", shown, total, noun)
+}
@@ -0,0 +1,35 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShorten(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		max      int
+		expected string
+	}{
+		{name: "it keeps short strings", input: "short", max: 10, expected: "short"},
+		{name: "it keeps strings of max length", input: "exact", max: 5, expected: "exact"},
+		{name: "it truncates long strings", input: "truncated", max: 5, expected: "trun…"},
+		{name: "it replaces line breaks", input: "multi\nline  text", max: 20, expected: "multi line text"},
+		{name: "it counts runes", input: "żółć gęślą", max: 4, expected: "żół…"},
+		{name: "it handles max of one", input: "text", max: 1, expected: "…"},
+		{name: "it handles zero max", input: "text", max: 0, expected: ""},
+		{name: "it handles negative max", input: "text", max: -1, expected: ""},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, Shorten(tc.input, tc.max))
+		})
+	}
+}
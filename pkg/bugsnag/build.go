@@ -0,0 +1,61 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Build is a request body of the build api. It records a release of an app
+// along with the source control revision it was built from.
+type Build struct {
+	APIKey           string            `json:"apiKey"`
+	AppVersion       string            `json:"appVersion"`
+	AppVersionCode   string            `json:"appVersionCode,omitempty"`
+	AppBundleVersion string            `json:"appBundleVersion,omitempty"`
+	ReleaseStage     string            `json:"releaseStage,omitempty"`
+	BuilderName      string            `json:"builderName,omitempty"`
+	SourceControl    *SourceControl    `json:"sourceControl,omitempty"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+}
+
+// BuildResponse holds response from the build api.
+type BuildResponse struct {
+	Status   string   `json:"status"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Validate checks that required fields of the build are set.
+func (b *Build) Validate() error {
+	if b.APIKey == "" {
+		return fmt.Errorf("bugsnag: build api key is required")
+	}
+	if b.AppVersion == "" {
+		return fmt.Errorf("bugsnag: build app version is required")
+	}
+	if sc := b.SourceControl; sc != nil && (sc.Repository == "") != (sc.Revision == "") {
+		return fmt.Errorf("bugsnag: build source control needs both a repository and a revision")
+	}
+	return nil
+}
+
+// NotifyBuild reports a build to the build api. The build is authenticated
+// with the project's notifier api key rather than the client's api token.
+func (c *Client) NotifyBuild(build *Build) (*BuildResponse, error) {
+	if err := build.Validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := c.send(context.Background(), http.MethodPost, c.build_endpoint, false, build, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	var out BuildResponse
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
@@ -0,0 +1,81 @@
+package bugsnag
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifyBuild(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"apiKey":       "abc",
+			"appVersion":   "2.4.1",
+			"releaseStage": "production",
+			"builderName":  "ci",
+			"sourceControl": map[string]interface{}{
+				"repository": "https://github.com/teamupstart/web-app",
+				"revision":   "9f2c4e1",
+			},
+		}, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"status":"ok","warnings":["releaseStage is not configured"]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: "http://127.0.0.1:1", BuildEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.NotifyBuild(&Build{
+		APIKey:       "abc",
+		AppVersion:   "2.4.1",
+		ReleaseStage: "production",
+		BuilderName:  "ci",
+		SourceControl: &SourceControl{
+			Repository: "https://github.com/teamupstart/web-app",
+			Revision:   "9f2c4e1",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", actual.Status)
+	assert.Equal(t, []string{"releaseStage is not configured"}, actual.Warnings)
+}
+
+func TestNotifyBuildErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(400)
+		_, _ = w.Write([]byte(`{"status":"error","errors":["apiKey is invalid"]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BuildEndpoint: server.URL})
+
+	_, err := client.NotifyBuild(&Build{APIKey: "abc", AppVersion: "2.4.1"})
+	assert.Error(t, err)
+	assert.IsType(t, &ErrUnexpectedResponse{}, err)
+	assert.Contains(t, err.Error(), "apiKey is invalid")
+
+	_, err = client.NotifyBuild(&Build{APIKey: "abc"})
+	assert.EqualError(t, err, "bugsnag: build app version is required")
+
+	_, err = client.NotifyBuild(&Build{
+		APIKey: "abc", AppVersion: "2.4.1", SourceControl: &SourceControl{Revision: "9f2c4e1"},
+	})
+	assert.Error(t, err)
+}
+
+func TestNewClientBuildEndpoint(t *testing.T) {
+	assert.Equal(t, DefaultBuildEndpoint, NewClient(Config{}).build_endpoint)
+}
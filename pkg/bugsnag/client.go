@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/http/httputil"
@@ -14,6 +15,13 @@ import (
 
 const (
 	ISO8601 = "2006-01-02T15:04:05Z"
+
+	// DefaultBuildEndpoint is the endpoint of the bugsnag build api.
+	DefaultBuildEndpoint = "https://build.bugsnag.com"
+	// DefaultNotifyEndpoint is the endpoint of the bugsnag error reporting api.
+	DefaultNotifyEndpoint = "https://notify.bugsnag.com"
+	// DefaultSessionsEndpoint is the endpoint of the bugsnag session tracking api.
+	DefaultSessionsEndpoint = "https://sessions.bugsnag.com"
 )
 
 var (
@@ -38,6 +46,8 @@ func (e *ErrUnexpectedResponse) Error() string {
 // multiple request fails when running them in a loop.
 type ErrMultipleFailed struct {
 	Msg string
+	// Failed is the number of items that failed, if known.
+	Failed int
 }
 
 func (e *ErrMultipleFailed) Error() string {
@@ -51,6 +61,51 @@ type Errors struct {
 	WarningMessages []string
 }
 
+// UnmarshalJSON decodes error response body. The api returns errors either
+// as a list of messages or as a map of field names to messages.
+func (e *Errors) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Errors          json.RawMessage `json:"errors"`
+		ErrorMessages   []string        `json:"errorMessages"`
+		WarningMessages []string        `json:"warningMessages"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	e.ErrorMessages = raw.ErrorMessages
+	e.WarningMessages = raw.WarningMessages
+
+	if len(raw.Errors) == 0 {
+		return nil
+	}
+
+	var messages []string
+	if err := json.Unmarshal(raw.Errors, &messages); err == nil {
+		e.ErrorMessages = append(e.ErrorMessages, messages...)
+		return nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw.Errors, &fields); err != nil {
+		return err
+	}
+	e.Errors = make(map[string]string, len(fields))
+	for k, v := range fields {
+		if list, ok := v.([]interface{}); ok {
+			msgs := make([]string, 0, len(list))
+			for _, m := range list {
+				msgs = append(msgs, fmt.Sprint(m))
+			}
+			e.Errors[k] = strings.Join(msgs, ", ")
+		} else {
+			e.Errors[k] = fmt.Sprint(v)
+		}
+	}
+
+	return nil
+}
+
 func (e Errors) String() string {
 	var out strings.Builder
 
@@ -80,22 +135,32 @@ type Header map[string]string
 // Config is a bugsnag config.
 type Config struct {
 	APIEndpoint string
-	Login       string
-	APIToken    string
-	AuthType    AuthType
-	Insecure    bool
-	Debug       bool
+	// BuildEndpoint is the endpoint builds are reported to, defaults to DefaultBuildEndpoint.
+	BuildEndpoint string
+	// NotifyEndpoint is the endpoint events are reported to, defaults to DefaultNotifyEndpoint.
+	NotifyEndpoint string
+	// SessionsEndpoint is the endpoint sessions are reported to, defaults to DefaultSessionsEndpoint.
+	SessionsEndpoint string
+	Login            string
+	APIToken         string
+	AuthType         AuthType
+	Insecure         bool
+	Debug            bool
 }
 
 // Client is a bugsnag client.
 type Client struct {
-	transport    http.RoundTripper
-	api_endpoint string
-	login        string
-	api_token    string
-	authType     AuthType
-	timeout      time.Duration
-	debug        bool
+	transport         http.RoundTripper
+	api_endpoint      string
+	build_endpoint    string
+	notify_endpoint   string
+	sessions_endpoint string
+	login             string
+	api_token         string
+	authType          AuthType
+	timeout           time.Duration
+	retryPolicy       RetryPolicy
+	debug             bool
 }
 
 // ClientFunc decorates option for client.
@@ -104,25 +169,43 @@ type ClientFunc func(*Client)
 // NewClient instantiates new bugsnag client.
 func NewClient(c Config, opts ...ClientFunc) *Client {
 	client := Client{
-		api_endpoint: strings.TrimSuffix(c.APIEndpoint, "/"),
-		login:        c.Login,
-		api_token:    c.APIToken,
-		authType:     c.AuthType,
-		debug:        c.Debug,
+		api_endpoint:      strings.TrimSuffix(c.APIEndpoint, "/"),
+		build_endpoint:    c.BuildEndpoint,
+		notify_endpoint:   c.NotifyEndpoint,
+		sessions_endpoint: c.SessionsEndpoint,
+		login:             c.Login,
+		api_token:         c.APIToken,
+		authType:          c.AuthType,
+		debug:             c.Debug,
+	}
+	if client.build_endpoint == "" {
+		client.build_endpoint = DefaultBuildEndpoint
+	}
+	if client.notify_endpoint == "" {
+		client.notify_endpoint = DefaultNotifyEndpoint
+	}
+	if client.sessions_endpoint == "" {
+		client.sessions_endpoint = DefaultSessionsEndpoint
 	}
 
 	for _, opt := range opts {
 		opt(&client)
 	}
 
-	client.transport = &http.Transport{
+	client.transport = NewTransport(client.timeout)
+
+	return &client
+}
+
+// NewTransport returns the transport bugsnag clients send requests with.
+// It needs no credentials, so it can be shared with other clients.
+func NewTransport(timeout time.Duration) http.RoundTripper {
+	return &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
-			Timeout: client.timeout,
+			Timeout: timeout,
 		}).DialContext,
 	}
-
-	return &client
 }
 
 // WithTimeout is a functional opt to attach timeout to the client.
@@ -134,10 +217,104 @@ func WithTimeout(to time.Duration) ClientFunc {
 
 // Get sends GET request to v3 version of the bugsnag api.
 func (c *Client) Get(ctx context.Context, path string, headers Header) (*http.Response, error) {
-	return c.request(ctx, http.MethodGet, c.api_endpoint+path, nil, headers)
+	return c.request(ctx, http.MethodGet, c.api_endpoint+path, true, nil, headers)
+}
+
+// Post sends POST request with json encoded body to v3 version of the bugsnag api.
+// Non 2xx responses are returned as ErrUnexpectedResponse.
+func (c *Client) Post(ctx context.Context, path string, body interface{}, headers Header) (*http.Response, error) {
+	return c.send(ctx, http.MethodPost, c.api_endpoint+path, true, body, headers)
+}
+
+// Patch sends PATCH request with json encoded body to v3 version of the bugsnag api.
+// Non 2xx responses are returned as ErrUnexpectedResponse.
+func (c *Client) Patch(ctx context.Context, path string, body interface{}, headers Header) (*http.Response, error) {
+	return c.send(ctx, http.MethodPatch, c.api_endpoint+path, true, body, headers)
+}
+
+// Put sends PUT request with json encoded body to v3 version of the bugsnag api.
+// Non 2xx responses are returned as ErrUnexpectedResponse.
+func (c *Client) Put(ctx context.Context, path string, body interface{}, headers Header) (*http.Response, error) {
+	return c.send(ctx, http.MethodPut, c.api_endpoint+path, true, body, headers)
+}
+
+// Delete sends DELETE request to v3 version of the bugsnag api.
+// Non 2xx responses are returned as ErrUnexpectedResponse.
+func (c *Client) Delete(ctx context.Context, path string, headers Header) (*http.Response, error) {
+	return c.send(ctx, http.MethodDelete, c.api_endpoint+path, true, nil, headers)
 }
 
-func (c *Client) request(ctx context.Context, method, endpoint string, body []byte, headers Header) (*http.Response, error) {
+// Request sends request with the given method and raw body to the bugsnag api.
+func (c *Client) Request(ctx context.Context, method, path string, body []byte, headers Header) (*http.Response, error) {
+	return c.request(ctx, method, c.api_endpoint+path, true, body, headers)
+}
+
+// send sends request with json encoded body to the endpoint. Requests to endpoints other
+// than the data access api authenticate with an api key in the body, so auth is optional.
+func (c *Client) send(
+	ctx context.Context, method, endpoint string, auth bool, body interface{}, headers Header,
+) (*http.Response, error) {
+	var (
+		b   []byte
+		err error
+	)
+
+	h := Header{"Accept": "application/json"}
+	if body != nil {
+		if b, err = json.Marshal(body); err != nil {
+			return nil, err
+		}
+		h["Content-Type"] = "application/json"
+	}
+	for k, v := range headers {
+		h[k] = v
+	}
+
+	res, err := c.request(ctx, method, endpoint, auth, b, h)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		defer func() { _ = res.Body.Close() }()
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	return res, nil
+}
+
+func (c *Client) request(
+	ctx context.Context, method, endpoint string, auth bool, body []byte, headers Header,
+) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := c.do(ctx, method, endpoint, auth, body, headers)
+
+		wait, retry := c.retryPolicy.ShouldRetry(method, attempt, res, err)
+		if !retry {
+			return res, err
+		}
+
+		if c.debug {
+			prettyPrintDump(
+				fmt.Sprintf("Retry %d of %d", attempt, c.retryPolicy.MaxAttempts-1),
+				[]byte(fmt.Sprintf("Request %s, retrying in %s\n", retryReason(res, err), wait.Round(time.Millisecond))),
+			)
+		}
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *Client) do(
+	ctx context.Context, method, endpoint string, auth bool, body []byte, headers Header,
+) (*http.Response, error) {
 	var (
 		req *http.Request
 		res *http.Response
@@ -159,9 +336,11 @@ func (c *Client) request(ctx context.Context, method, endpoint string, body []by
 		req.Header.Set(k, v)
 	}
 
-	if c.authType == AuthTypeBasic {
+	switch {
+	case !auth:
+	case c.authType == AuthTypeBasic:
 		req.SetBasicAuth(c.login, c.api_token)
-	} else {
+	default:
 		req.Header.Add("Authorization", "token "+c.api_token)
 	}
 
@@ -172,10 +351,12 @@ func (c *Client) request(ctx context.Context, method, endpoint string, body []by
 
 func dump(req *http.Request, res *http.Response) {
 	reqDump, _ := httputil.DumpRequest(req, true)
-	respDump, _ := httputil.DumpResponse(res, false)
-
 	prettyPrintDump("Request Details", reqDump)
-	prettyPrintDump("Response Details", respDump)
+
+	if res != nil {
+		respDump, _ := httputil.DumpResponse(res, false)
+		prettyPrintDump("Response Details", respDump)
+	}
 }
 
 func prettyPrintDump(heading string, data []byte) {
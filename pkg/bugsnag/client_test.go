@@ -0,0 +1,136 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteMethods(t *testing.T) {
+	type payload struct {
+		Operation string `json:"operation"`
+	}
+
+	cases := []struct {
+		name   string
+		method string
+		call   func(c *Client) (*http.Response, error)
+		body   string
+	}{
+		{
+			name:   "post",
+			method: http.MethodPost,
+			call: func(c *Client) (*http.Response, error) {
+				return c.Post(context.Background(), "/resource", payload{Operation: "create"}, nil)
+			},
+			body: `{"operation":"create"}`,
+		},
+		{
+			name:   "patch",
+			method: http.MethodPatch,
+			call: func(c *Client) (*http.Response, error) {
+				return c.Patch(context.Background(), "/resource", payload{Operation: "fix"}, Header{"X-Test": "1"})
+			},
+			body: `{"operation":"fix"}`,
+		},
+		{
+			name:   "put",
+			method: http.MethodPut,
+			call: func(c *Client) (*http.Response, error) {
+				return c.Put(context.Background(), "/resource", map[string]int{"value": 1}, nil)
+			},
+			body: `{"value":1}`,
+		},
+		{
+			name:   "delete",
+			method: http.MethodDelete,
+			call: func(c *Client) (*http.Response, error) {
+				return c.Delete(context.Background(), "/resource", nil)
+			},
+			body: ``,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.method, r.Method)
+				assert.Equal(t, "/resource", r.URL.Path)
+				assert.Equal(t, "token secret", r.Header.Get("Authorization"))
+				assert.Equal(t, "application/json", r.Header.Get("Accept"))
+
+				b, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.body, string(b))
+
+				if tc.body != "" {
+					assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				}
+				if tc.method == http.MethodPatch {
+					assert.Equal(t, "1", r.Header.Get("X-Test"))
+				}
+				if tc.method == http.MethodDelete {
+					w.WriteHeader(204)
+					return
+				}
+				w.WriteHeader(200)
+				_, _ = w.Write([]byte(`{"ok": true}`))
+			}))
+			defer server.Close()
+
+			client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+			res, err := tc.call(client)
+			assert.NoError(t, err)
+			_ = res.Body.Close()
+		})
+	}
+}
+
+func TestWriteMethodsUnexpectedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/list" {
+			w.WriteHeader(400)
+			_, _ = w.Write([]byte(`{"errors": ["operation is invalid"]}`))
+			return
+		}
+		w.WriteHeader(422)
+		_, _ = w.Write([]byte(`{"errors": {"message": ["can't be blank"]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	_, err := client.Patch(context.Background(), "/list", map[string]string{"operation": "nope"}, nil)
+	assert.IsType(t, &ErrUnexpectedResponse{}, err)
+
+	e := err.(*ErrUnexpectedResponse)
+	assert.Equal(t, 400, e.StatusCode)
+	assert.Equal(t, []string{"operation is invalid"}, e.Body.ErrorMessages)
+	assert.Equal(t, "\nError:\n  - operation is invalid\n", e.Error())
+
+	_, err = client.Post(context.Background(), "/map", map[string]string{}, nil)
+	assert.IsType(t, &ErrUnexpectedResponse{}, err)
+
+	e = err.(*ErrUnexpectedResponse)
+	assert.Equal(t, 422, e.StatusCode)
+	assert.Equal(t, map[string]string{"message": "can't be blank"}, e.Body.Errors)
+}
+
+func TestErrorsUnmarshal(t *testing.T) {
+	var e Errors
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"errorMessages": ["a"], "warningMessages": ["b"]}`), &e))
+	assert.Equal(t, []string{"a"}, e.ErrorMessages)
+	assert.Equal(t, []string{"b"}, e.WarningMessages)
+	assert.Nil(t, e.Errors)
+}
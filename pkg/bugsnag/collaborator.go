@@ -0,0 +1,41 @@
+package bugsnag
+
+import (
+	"context"
+	"net/url"
+)
+
+// Collaborator holds response from /organizations/{organization_id}/collaborators endpoint.
+type Collaborator struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Email             string `json:"email"`
+	IsAdmin           bool   `json:"is_admin"`
+	PendingInvitation bool   `json:"pending_invitation"`
+	TwoFactorEnabled  bool   `json:"two_factor_enabled"`
+	PaidFor           bool   `json:"paid_for"`
+	LastRequestAt     string `json:"last_request_at"`
+	CreatedAt         string `json:"created_at"`
+}
+
+// Role returns a human readable role of the collaborator.
+func (c *Collaborator) Role() string {
+	switch {
+	case c.PendingInvitation:
+		return "invited"
+	case c.IsAdmin:
+		return "admin"
+	default:
+		return "member"
+	}
+}
+
+// CollaboratorsPager returns a pager over /organizations/{orgID}/collaborators endpoint.
+func (c *Client) CollaboratorsPager(orgID string, opts *ListOptions) *Pager[*Collaborator] {
+	return newPager[*Collaborator](c, "/organizations/"+url.PathEscape(orgID)+"/collaborators", nil, nil, opts)
+}
+
+// ListCollaborators fetches all pages from /organizations/{orgID}/collaborators endpoint.
+func (c *Client) ListCollaborators(orgID string) ([]*Collaborator, error) {
+	return Collect(context.Background(), c.CollaboratorsPager(orgID, nil), 0)
+}
@@ -0,0 +1,34 @@
+package bugsnag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCollaborators(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/organizations/5f0c1a2b3c4d5e6f7a8b9c0d/collaborators", r.URL.Path)
+
+		resp, err := os.ReadFile("./testdata/collaborators.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.ListCollaborators("5f0c1a2b3c4d5e6f7a8b9c0d")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "jane@example.com", actual[0].Email)
+	assert.Equal(t, "admin", actual[0].Role())
+	assert.Equal(t, "invited", actual[1].Role())
+	assert.Empty(t, actual[1].LastRequestAt)
+}
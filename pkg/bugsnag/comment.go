@@ -0,0 +1,106 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Comment holds response from /projects/{project_id}/errors/{error_id}/comments endpoint.
+type Comment struct {
+	ID           string        `json:"id"`
+	Message      string        `json:"message"`
+	CreatedAt    string        `json:"created_at"`
+	UpdatedAt    string        `json:"updated_at,omitempty"`
+	Collaborator *Collaborator `json:"collaborator,omitempty"`
+}
+
+// Author returns name or email of the comment author.
+func (c *Comment) Author() string {
+	if c.Collaborator == nil {
+		return ""
+	}
+	if c.Collaborator.Name != "" {
+		return c.Collaborator.Name
+	}
+	return c.Collaborator.Email
+}
+
+type commentRequest struct {
+	Message string `json:"message"`
+}
+
+// CommentsPager returns a pager over /projects/{projectID}/errors/{errorID}/comments endpoint.
+func (c *Client) CommentsPager(projectID, errorID string, opts *ListOptions) *Pager[*Comment] {
+	return newPager[*Comment](c, commentsPath(projectID, errorID), nil, nil, opts)
+}
+
+// ListComments fetches all comments of an error.
+func (c *Client) ListComments(projectID, errorID string) ([]*Comment, error) {
+	return Collect(context.Background(), c.CommentsPager(projectID, errorID, nil), 0)
+}
+
+// GetComment fetches a comment from /comments/{commentID} endpoint.
+func (c *Client) GetComment(commentID string) (*Comment, error) {
+	res, err := c.Get(context.Background(), "/comments/"+url.PathEscape(commentID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	if res.StatusCode != http.StatusOK {
+		defer func() { _ = res.Body.Close() }()
+		return nil, formatUnexpectedResponse(res)
+	}
+	return decodeComment(res, nil)
+}
+
+// AddComment adds a comment to an error using POST /projects/{projectID}/errors/{errorID}/comments endpoint.
+func (c *Client) AddComment(projectID, errorID, message string) (*Comment, error) {
+	res, err := c.Post(context.Background(), commentsPath(projectID, errorID), &commentRequest{Message: message}, nil)
+	return decodeComment(res, err)
+}
+
+// EditComment updates message of a comment using PATCH /comments/{commentID} endpoint.
+func (c *Client) EditComment(commentID, message string) (*Comment, error) {
+	res, err := c.Patch(context.Background(), "/comments/"+url.PathEscape(commentID), &commentRequest{Message: message}, nil)
+	return decodeComment(res, err)
+}
+
+// DeleteComment deletes a comment using DELETE /comments/{commentID} endpoint.
+func (c *Client) DeleteComment(commentID string) error {
+	res, err := c.Delete(context.Background(), "/comments/"+url.PathEscape(commentID), nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	_ = res.Body.Close()
+
+	return nil
+}
+
+func commentsPath(projectID, errorID string) string {
+	return "/projects/" + url.PathEscape(projectID) + "/errors/" + url.PathEscape(errorID) + "/comments"
+}
+
+func decodeComment(res *http.Response, err error) (*Comment, error) {
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	var out Comment
+
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
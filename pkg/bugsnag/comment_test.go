@@ -0,0 +1,75 @@
+package bugsnag
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/p1/errors/e1/comments", r.URL.Path)
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[{"id": "c1", "message": "Looking", "collaborator": {"id": "u1", "name": "", "email": "jane@example.com"}}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.ListComments("p1", "e1")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, "Looking", actual[0].Message)
+	assert.Equal(t, "jane@example.com", actual[0].Author())
+}
+
+func TestAddComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/projects/p1/errors/e1/comments", r.URL.Path)
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"message": "Rolled back"}, body)
+
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{"id": "c2", "message": "Rolled back"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.AddComment("p1", "e1", "Rolled back")
+	assert.NoError(t, err)
+	assert.Equal(t, "c2", actual.ID)
+}
+
+func TestEditAndDeleteComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/comments/c1", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodPatch:
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"id": "c1", "message": "Fixed"}`))
+		case http.MethodDelete:
+			w.WriteHeader(404)
+			_, _ = w.Write([]byte(`{"errors": ["comment not found"]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.EditComment("c1", "Fixed")
+	assert.NoError(t, err)
+	assert.Equal(t, "Fixed", actual.Message)
+
+	err = client.DeleteComment("c1")
+	assert.IsType(t, &ErrUnexpectedResponse{}, err)
+	assert.Equal(t, 404, err.(*ErrUnexpectedResponse).StatusCode)
+}
@@ -0,0 +1,197 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// ErrorSortLastSeen sorts errors by the time they were last seen.
+	ErrorSortLastSeen = "last_seen"
+	// ErrorSortFirstSeen sorts errors by the time they were first seen.
+	ErrorSortFirstSeen = "first_seen"
+	// ErrorSortEvents sorts errors by number of events.
+	ErrorSortEvents = "events"
+	// ErrorSortUsers sorts errors by number of affected users.
+	ErrorSortUsers = "users"
+	// ErrorSortUnsorted doesn't sort errors.
+	ErrorSortUnsorted = "unsorted"
+)
+
+// Error holds response from /projects/{project_id}/errors endpoint.
+type Error struct {
+	ID                     string       `json:"id"`
+	ProjectID              string       `json:"project_id"`
+	URL                    string       `json:"url"`
+	ProjectURL             string       `json:"project_url"`
+	ErrorClass             string       `json:"error_class"`
+	Message                string       `json:"message"`
+	Context                string       `json:"context"`
+	Severity               string       `json:"severity"`
+	OriginalSeverity       string       `json:"original_severity"`
+	OverriddenSeverity     string       `json:"overridden_severity"`
+	Status                 string       `json:"status"`
+	Events                 int          `json:"events"`
+	EventsURL              string       `json:"events_url"`
+	Users                  int          `json:"users"`
+	CommentCount           int          `json:"comment_count"`
+	ReleaseStages          []string     `json:"release_stages"`
+	AssignedCollaboratorID string       `json:"assigned_collaborator_id,omitempty"`
+	ReopenRules            *ReopenRules `json:"reopen_rules,omitempty"`
+	FirstSeen              string       `json:"first_seen"`
+	LastSeen               string       `json:"last_seen"`
+	FirstSeenUnfiltered    string       `json:"first_seen_unfiltered"`
+	LastSeenUnfiltered     string       `json:"last_seen_unfiltered"`
+}
+
+// ReopenRules are the conditions under which a snoozed error is reopened.
+type ReopenRules struct {
+	ReopenIf              string `json:"reopen_if"`
+	Seconds               int    `json:"seconds,omitempty"`
+	Occurrences           int    `json:"occurrences,omitempty"`
+	Hours                 int    `json:"hours,omitempty"`
+	OccurrenceThreshold   int    `json:"occurrence_threshold,omitempty"`
+	AdditionalOccurrences int    `json:"additional_occurrences,omitempty"`
+	AdditionalUsers       int    `json:"additional_users,omitempty"`
+}
+
+// ListErrorsOptions holds sorting and pagination options for ListErrors.
+type ListErrorsOptions struct {
+	ListOptions
+
+	Sort      string
+	Direction string
+}
+
+// ErrorsPager returns a pager over /projects/{projectID}/errors endpoint.
+func (c *Client) ErrorsPager(projectID string, filters Filters, opts *ListErrorsOptions) *Pager[*Error] {
+	params := url.Values{}
+	if opts == nil {
+		opts = &ListErrorsOptions{}
+	}
+	if opts.Sort != "" {
+		params.Set("sort", opts.Sort)
+	}
+	if opts.Direction != "" {
+		params.Set("direction", opts.Direction)
+	}
+
+	return newPager[*Error](c, "/projects/"+url.PathEscape(projectID)+"/errors", params, filters, &opts.ListOptions)
+}
+
+// ListErrors fetches pages from /projects/{projectID}/errors endpoint until opts.Limit errors are collected.
+func (c *Client) ListErrors(projectID string, filters Filters, opts *ListErrorsOptions) ([]*Error, error) {
+	if opts == nil {
+		opts = &ListErrorsOptions{}
+	}
+	return Collect(context.Background(), c.ErrorsPager(projectID, filters, opts), opts.Limit)
+}
+
+// GetError fetches response from /projects/{projectID}/errors/{errorID} endpoint.
+func (c *Client) GetError(projectID, errorID string) (*Error, error) {
+	res, err := c.Get(context.Background(), "/projects/"+url.PathEscape(projectID)+"/errors/"+url.PathEscape(errorID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Error
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+const (
+	// ErrorOperationFix marks errors as fixed.
+	ErrorOperationFix = "fix"
+	// ErrorOperationIgnore ignores errors.
+	ErrorOperationIgnore = "ignore"
+	// ErrorOperationOpen reopens errors.
+	ErrorOperationOpen = "open"
+	// ErrorOperationSnooze snoozes errors until reopen rules are met.
+	ErrorOperationSnooze = "snooze"
+	// ErrorOperationAssign assigns errors to a collaborator.
+	ErrorOperationAssign = "assign"
+
+	// ReopenIfOccursAfter reopens snoozed error if it occurs after given seconds.
+	ReopenIfOccursAfter = "occurs_after"
+	// ReopenIfAdditionalOccurrences reopens snoozed error after given number of additional events.
+	ReopenIfAdditionalOccurrences = "n_additional_occurrences"
+	// ReopenIfAdditionalUsers reopens snoozed error after given number of additional affected users.
+	ReopenIfAdditionalUsers = "n_additional_users"
+
+	// updateErrorsBatchSize is the number of error ids sent in a single bulk update request.
+	updateErrorsBatchSize = 50
+)
+
+// ErrorUpdate is a request body to update errors.
+type ErrorUpdate struct {
+	Operation   string       `json:"operation"`
+	ReopenRules *ReopenRules `json:"reopen_rules,omitempty"`
+	// AssignedCollaboratorID is used by the assign operation, nil unassigns the errors.
+	AssignedCollaboratorID *string `json:"assigned_collaborator_id,omitempty"`
+}
+
+// MarshalJSON encodes the update, sending assigned_collaborator_id
+// as null for the assign operation if no collaborator is set.
+func (u ErrorUpdate) MarshalJSON() ([]byte, error) {
+	type update ErrorUpdate
+
+	if u.Operation != ErrorOperationAssign || u.AssignedCollaboratorID != nil {
+		return json.Marshal(update(u))
+	}
+
+	return json.Marshal(struct {
+		update
+		AssignedCollaboratorID *string `json:"assigned_collaborator_id"`
+	}{update: update(u)})
+}
+
+// UpdateErrors applies the update to errors using /projects/{projectID}/errors bulk endpoint.
+// Errors are updated in batches and failed batches are reported as ErrMultipleFailed.
+func (c *Client) UpdateErrors(projectID string, errorIDs []string, update *ErrorUpdate) error {
+	var (
+		failed  []string
+		nFailed int
+	)
+
+	for start := 0; start < len(errorIDs); start += updateErrorsBatchSize {
+		end := start + updateErrorsBatchSize
+		if end > len(errorIDs) {
+			end = len(errorIDs)
+		}
+		batch := errorIDs[start:end]
+
+		params := url.Values{"error_ids[]": batch}
+		path := "/projects/" + url.PathEscape(projectID) + "/errors?" + params.Encode()
+
+		res, err := c.Patch(context.Background(), path, update, nil)
+		if err != nil {
+			msg := err.Error()
+			if e, ok := err.(*ErrUnexpectedResponse); ok {
+				msg = strings.TrimSpace(e.Status + " " + strings.Join(strings.Fields(e.Error()), " "))
+			}
+			failed = append(failed, fmt.Sprintf("  - %s: %s", strings.Join(batch, ", "), msg))
+			nFailed += len(batch)
+			continue
+		}
+		_ = res.Body.Close()
+	}
+
+	if len(failed) > 0 {
+		return &ErrMultipleFailed{Msg: "\n" + strings.Join(failed, "\n"), Failed: nFailed}
+	}
+	return nil
+}
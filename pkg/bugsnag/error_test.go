@@ -0,0 +1,158 @@
+package bugsnag
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/p1/errors", r.URL.Path)
+		assert.Equal(t, "users", r.URL.Query().Get("sort"))
+		assert.Equal(t, "desc", r.URL.Query().Get("direction"))
+		assert.Equal(t, "eq", r.URL.Query().Get("filters[error.status][][type]"))
+		assert.Equal(t, "open", r.URL.Query().Get("filters[error.status][][value]"))
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[{"id": "e1", "error_class": "TypeError", "events": 3, "users": 2}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	filters := Filters{}
+	filters.Add("error.status", FilterTypeEq, "open")
+
+	actual, err := client.ListErrors("p1", filters, &ListErrorsOptions{Sort: ErrorSortUsers, Direction: "desc"})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, "TypeError", actual[0].ErrorClass)
+	assert.Equal(t, 2, actual[0].Users)
+}
+
+func TestUpdateErrors(t *testing.T) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/projects/p1/errors", r.URL.Path)
+
+		var body ErrorUpdate
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, ErrorOperationSnooze, body.Operation)
+		assert.Equal(t, ReopenIfOccursAfter, body.ReopenRules.ReopenIf)
+		assert.Equal(t, 3600, body.ReopenRules.Seconds)
+
+		ids := r.URL.Query()["error_ids[]"]
+		if n == 3 {
+			assert.Len(t, ids, 20)
+		} else {
+			assert.Len(t, ids, updateErrorsBatchSize)
+		}
+
+		if n == 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors": ["invalid error id"]}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	ids := make([]string, 120)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("e%d", i)
+	}
+
+	err := client.UpdateErrors("p1", ids, &ErrorUpdate{
+		Operation:   ErrorOperationSnooze,
+		ReopenRules: &ReopenRules{ReopenIf: ReopenIfOccursAfter, Seconds: 3600},
+	})
+	assert.Equal(t, int32(3), requests)
+	assert.IsType(t, &ErrMultipleFailed{}, err)
+	assert.Equal(t, updateErrorsBatchSize, err.(*ErrMultipleFailed).Failed)
+	assert.True(t, strings.HasPrefix(err.Error(), "\n  - e50, e51,"))
+	assert.Contains(t, err.Error(), "e99: 400 Bad Request Error: - invalid error id")
+	assert.NotContains(t, err.Error(), "e49,")
+	assert.NotContains(t, err.Error(), "e100")
+}
+
+func TestGetError(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/p1/errors/e1", r.URL.Path)
+		assert.Equal(t, "token secret", r.Header.Get("Authorization"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			_, _ = w.Write([]byte(`{"errorMessages": ["Not found"]}`))
+			return
+		}
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{
+			"id": "e1",
+			"error_class": "TypeError",
+			"message": "undefined is not a function",
+			"status": "open",
+			"events": 12,
+			"release_stages": ["production", "staging"]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.GetError("p1", "e1")
+	assert.NoError(t, err)
+	assert.Equal(t, "TypeError", actual.ErrorClass)
+	assert.Equal(t, 12, actual.Events)
+	assert.Equal(t, []string{"production", "staging"}, actual.ReleaseStages)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetError("p1", "e1")
+	assert.IsType(t, &ErrUnexpectedResponse{}, err)
+	assert.Equal(t, 404, err.(*ErrUnexpectedResponse).StatusCode)
+	assert.Equal(t, []string{"Not found"}, err.(*ErrUnexpectedResponse).Body.ErrorMessages)
+}
+
+func TestErrorUpdateMarshal(t *testing.T) {
+	id := "c1"
+
+	cases := []struct {
+		input    ErrorUpdate
+		expected string
+	}{
+		{
+			input:    ErrorUpdate{Operation: ErrorOperationFix},
+			expected: `{"operation":"fix"}`,
+		},
+		{
+			input:    ErrorUpdate{Operation: ErrorOperationAssign, AssignedCollaboratorID: &id},
+			expected: `{"operation":"assign","assigned_collaborator_id":"c1"}`,
+		},
+		{
+			input:    ErrorUpdate{Operation: ErrorOperationAssign},
+			expected: `{"operation":"assign","assigned_collaborator_id":null}`,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := json.Marshal(&tc.input)
+		assert.NoError(t, err)
+		assert.JSONEq(t, tc.expected, string(actual))
+	}
+}
@@ -0,0 +1,182 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Event holds response from /projects/{project_id}/events endpoint.
+type Event struct {
+	ID           string                 `json:"id"`
+	URL          string                 `json:"url"`
+	ProjectURL   string                 `json:"project_url"`
+	ErrorID      string                 `json:"error_id"`
+	IsFullReport bool                   `json:"is_full_report"`
+	Context      string                 `json:"context"`
+	Severity     string                 `json:"severity"`
+	Unhandled    bool                   `json:"unhandled"`
+	ReceivedAt   string                 `json:"received_at"`
+	Exceptions   []*Exception           `json:"exceptions"`
+	Threads      []*Thread              `json:"threads"`
+	Breadcrumbs  []*Breadcrumb          `json:"breadcrumbs"`
+	Request      *Request               `json:"request,omitempty"`
+	User         *EventUser             `json:"user,omitempty"`
+	App          map[string]interface{} `json:"app,omitempty"`
+	Device       map[string]interface{} `json:"device,omitempty"`
+	MetaData     map[string]interface{} `json:"metaData,omitempty"`
+
+	// Raw is the event as returned by the api, including fields unknown to
+	// this package. It is set by GetEvent and GetLatestEvent only.
+	Raw json.RawMessage `json:"-"`
+}
+
+// Exception is an exception reported in an event.
+type Exception struct {
+	ErrorClass string        `json:"error_class"`
+	Message    string        `json:"message"`
+	Type       string        `json:"type"`
+	Stacktrace []*StackFrame `json:"stacktrace"`
+}
+
+// StackFrame is a single frame of a stacktrace.
+type StackFrame struct {
+	File         string            `json:"file"`
+	Method       string            `json:"method"`
+	LineNumber   int               `json:"line_number"`
+	ColumnNumber int               `json:"column_number"`
+	InProject    bool              `json:"in_project"`
+	Code         map[string]string `json:"code,omitempty"`
+}
+
+// Thread is a thread captured in an event.
+type Thread struct {
+	ID                   string        `json:"id"`
+	Name                 string        `json:"name"`
+	Type                 string        `json:"type"`
+	ErrorReportingThread bool          `json:"error_reporting_thread"`
+	Stacktrace           []*StackFrame `json:"stacktrace"`
+}
+
+// Breadcrumb is an action that happened before an event.
+type Breadcrumb struct {
+	Timestamp string                 `json:"timestamp"`
+	Name      string                 `json:"name"`
+	Type      string                 `json:"type"`
+	MetaData  map[string]interface{} `json:"meta_data,omitempty"`
+}
+
+// Request is the http request captured in an event.
+type Request struct {
+	URL        string            `json:"url"`
+	HTTPMethod string            `json:"httpMethod"`
+	ClientIP   string            `json:"clientIp"`
+	Referer    string            `json:"referer"`
+	Headers    map[string]string `json:"headers,omitempty"`
+}
+
+// EventUser is the user affected by an event.
+type EventUser struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// ListEventsOptions holds sorting and pagination options for ListEvents.
+type ListEventsOptions struct {
+	ListOptions
+
+	Direction string
+	// FullReports requests complete event payloads instead of summaries.
+	FullReports bool
+}
+
+// Exception returns the first exception of the event, or nil if there is none.
+func (e *Event) Exception() *Exception {
+	if len(e.Exceptions) == 0 {
+		return nil
+	}
+	return e.Exceptions[0]
+}
+
+// EventsPager returns a pager over events of an error, or of the whole
+// project if errorID is empty.
+func (c *Client) EventsPager(projectID, errorID string, filters Filters, opts *ListEventsOptions) *Pager[*Event] {
+	path, params, opts := eventsQuery(projectID, errorID, opts)
+	return newPager[*Event](c, path, params, filters, &opts.ListOptions)
+}
+
+// RawEventsPager is like EventsPager but leaves events undecoded so
+// that fields unknown to this package are preserved.
+func (c *Client) RawEventsPager(projectID, errorID string, filters Filters, opts *ListEventsOptions) *Pager[json.RawMessage] {
+	path, params, opts := eventsQuery(projectID, errorID, opts)
+	return newPager[json.RawMessage](c, path, params, filters, &opts.ListOptions)
+}
+
+func eventsQuery(projectID, errorID string, opts *ListEventsOptions) (string, url.Values, *ListEventsOptions) {
+	params := url.Values{}
+	if opts == nil {
+		opts = &ListEventsOptions{}
+	}
+	if opts.Direction != "" {
+		params.Set("direction", opts.Direction)
+	}
+	if opts.FullReports {
+		params.Set("full_reports", "true")
+	}
+
+	path := "/projects/" + url.PathEscape(projectID)
+	if errorID != "" {
+		path += "/errors/" + url.PathEscape(errorID)
+	}
+
+	return path + "/events", params, opts
+}
+
+// ListEvents fetches pages of events until opts.Limit events are collected.
+// Events of the whole project are fetched if errorID is empty.
+func (c *Client) ListEvents(projectID, errorID string, filters Filters, opts *ListEventsOptions) ([]*Event, error) {
+	if opts == nil {
+		opts = &ListEventsOptions{}
+	}
+	return Collect(context.Background(), c.EventsPager(projectID, errorID, filters, opts), opts.Limit)
+}
+
+// GetEvent fetches response from /projects/{projectID}/events/{eventID} endpoint.
+func (c *Client) GetEvent(projectID, eventID string) (*Event, error) {
+	return c.getEvent("/projects/" + url.PathEscape(projectID) + "/events/" + url.PathEscape(eventID))
+}
+
+// GetLatestEvent fetches response from /projects/{projectID}/errors/{errorID}/latest_event endpoint.
+func (c *Client) GetLatestEvent(projectID, errorID string) (*Event, error) {
+	return c.getEvent("/projects/" + url.PathEscape(projectID) + "/errors/" + url.PathEscape(errorID) + "/latest_event")
+}
+
+func (c *Client) getEvent(path string) (*Event, error) {
+	res, err := c.Get(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var out Event
+
+	err = json.Unmarshal(body, &out)
+	out.Raw = body
+
+	return &out, err
+}
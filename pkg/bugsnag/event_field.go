@@ -0,0 +1,39 @@
+package bugsnag
+
+import (
+	"context"
+	"net/url"
+)
+
+// EventField holds response from /projects/{project_id}/event_fields endpoint.
+type EventField struct {
+	DisplayID     string                   `json:"display_id"`
+	Custom        bool                     `json:"custom"`
+	FilterOptions *EventFieldFilterOptions `json:"filter_options,omitempty"`
+	PivotOptions  map[string]interface{}   `json:"pivot_options,omitempty"`
+}
+
+// EventFieldFilterOptions describes how an event field can be filtered on.
+type EventFieldFilterOptions struct {
+	Name       string   `json:"name"`
+	MatchTypes []string `json:"match_types,omitempty"`
+}
+
+// Name returns the human readable name of the field.
+func (f *EventField) Name() string {
+	if f.FilterOptions == nil {
+		return ""
+	}
+	return f.FilterOptions.Name
+}
+
+// Pivotable reports whether the field can be pivoted on.
+func (f *EventField) Pivotable() bool {
+	return f.PivotOptions != nil
+}
+
+// ListEventFields fetches all pages from /projects/{projectID}/event_fields endpoint.
+func (c *Client) ListEventFields(projectID string) ([]*EventField, error) {
+	pager := newPager[*EventField](c, "/projects/"+url.PathEscape(projectID)+"/event_fields", nil, nil, nil)
+	return Collect(context.Background(), pager, 0)
+}
@@ -0,0 +1,32 @@
+package bugsnag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListEventFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/p1/event_fields", r.URL.Path)
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[
+			{"display_id": "app.version", "custom": false, "filter_options": {"name": "App version", "match_types": ["eq", "ne"]}, "pivot_options": {}},
+			{"display_id": "metaData.cart.total", "custom": true, "filter_options": {"name": "Cart total"}}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.ListEventFields("p1")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "App version", actual[0].Name())
+	assert.True(t, actual[0].Pivotable())
+	assert.False(t, actual[1].Pivotable())
+	assert.True(t, actual[1].Custom)
+}
@@ -0,0 +1,107 @@
+package bugsnag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/projects/p1/events":
+			assert.Equal(t, "asc", r.URL.Query().Get("direction"))
+			assert.Equal(t, "2022-07-01T00:00:00Z", r.URL.Query().Get("filters[event.since][][value]"))
+		case "/projects/p1/errors/e1/events":
+			assert.Empty(t, r.URL.Query().Get("direction"))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[{"id": "ev1", "error_id": "e1", "exceptions": [{"error_class": "TypeError"}]}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	filters := Filters{}
+	filters.Add("event.since", FilterTypeEq, "2022-07-01T00:00:00Z")
+
+	actual, err := client.ListEvents("p1", "", filters, &ListEventsOptions{Direction: "asc"})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, "TypeError", actual[0].Exception().ErrorClass)
+
+	actual, err = client.ListEvents("p1", "e1", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "ev1", actual[0].ID)
+}
+
+func TestGetEvent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/p1/events/ev1", r.URL.Path)
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{
+			"id": "ev1",
+			"breadcrumbs": [{"name": "Clicked", "type": "user", "meta_data": {"target": "#pay"}}],
+			"request": {"url": "https://example.com", "httpMethod": "POST"},
+			"metaData": {"cart": {"items": 3}},
+			"correlation": {"traceId": "t1"}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.GetEvent("p1", "ev1")
+	assert.NoError(t, err)
+	assert.Nil(t, actual.Exception())
+	assert.Equal(t, "#pay", actual.Breadcrumbs[0].MetaData["target"])
+	assert.Equal(t, "POST", actual.Request.HTTPMethod)
+	assert.Contains(t, actual.MetaData, "cart")
+	assert.Contains(t, string(actual.Raw), `"correlation": {"traceId": "t1"}`)
+}
+
+func TestGetLatestEvent(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/p1/errors/e1/latest_event", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(500)
+			return
+		}
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{
+			"id": "ev2",
+			"error_id": "e1",
+			"exceptions": [{
+				"error_class": "TypeError",
+				"stacktrace": [
+					{"file": "app.js", "method": "pay", "line_number": 10, "in_project": true},
+					{"file": "vendor.js", "method": "call", "line_number": 200}
+				]
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.GetLatestEvent("p1", "e1")
+	assert.NoError(t, err)
+	assert.Equal(t, "ev2", actual.ID)
+	assert.Len(t, actual.Exceptions[0].Stacktrace, 2)
+	assert.True(t, actual.Exceptions[0].Stacktrace[0].InProject)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetLatestEvent("p1", "e1")
+	assert.IsType(t, &ErrUnexpectedResponse{}, err)
+}
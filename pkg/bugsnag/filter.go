@@ -0,0 +1,72 @@
+package bugsnag
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	// FilterTypeEq matches events where the field equals the value.
+	FilterTypeEq FilterType = "eq"
+	// FilterTypeNe matches events where the field doesn't equal the value.
+	FilterTypeNe FilterType = "ne"
+	// FilterTypeEmpty matches events where the field is empty or missing.
+	FilterTypeEmpty FilterType = "empty"
+)
+
+// FilterType is a bugsnag filter comparison type.
+type FilterType string
+
+// Filter is a single filter condition on an event field.
+type Filter struct {
+	Type  FilterType `json:"type"`
+	Value string     `json:"value"`
+}
+
+// Filters maps event field ids, eg: error.status, to filter conditions.
+type Filters map[string][]Filter
+
+// Add appends a filter condition for the given field.
+func (f Filters) Add(field string, typ FilterType, value string) {
+	f[field] = append(f[field], Filter{Type: typ, Value: value})
+}
+
+// Encode encodes filters to the query string format expected by the api, eg:
+// filters[error.status][][type]=eq&filters[error.status][][value]=open
+//
+// Pairs are kept in order so that type and value of a condition stay together.
+func (f Filters) Encode() string {
+	fields := make([]string, 0, len(f))
+	for k := range f {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+
+	var parts []string
+	for _, field := range fields {
+		prefix := url.QueryEscape("filters[" + field + "][]")
+		for _, cond := range f[field] {
+			parts = append(parts,
+				prefix+url.QueryEscape("[type]")+"="+url.QueryEscape(string(cond.Type)),
+				prefix+url.QueryEscape("[value]")+"="+url.QueryEscape(cond.Value),
+			)
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+func buildQuery(params url.Values, filters Filters) string {
+	q := params.Encode()
+	if fq := filters.Encode(); fq != "" {
+		if q != "" {
+			q += "&"
+		}
+		q += fq
+	}
+	if q == "" {
+		return ""
+	}
+	return "?" + q
+}
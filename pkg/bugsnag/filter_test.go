@@ -0,0 +1,35 @@
+package bugsnag
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFiltersEncode(t *testing.T) {
+	filters := Filters{}
+	filters.Add("error.status", FilterTypeEq, "open")
+	filters.Add("app.release_stage", FilterTypeEq, "production")
+	filters.Add("app.release_stage", FilterTypeNe, "staging")
+
+	expected := "filters%5Bapp.release_stage%5D%5B%5D%5Btype%5D=eq&filters%5Bapp.release_stage%5D%5B%5D%5Bvalue%5D=production&" +
+		"filters%5Bapp.release_stage%5D%5B%5D%5Btype%5D=ne&filters%5Bapp.release_stage%5D%5B%5D%5Bvalue%5D=staging&" +
+		"filters%5Berror.status%5D%5B%5D%5Btype%5D=eq&filters%5Berror.status%5D%5B%5D%5Bvalue%5D=open"
+
+	assert.Equal(t, expected, filters.Encode())
+	assert.Equal(t, "", Filters{}.Encode())
+}
+
+func TestBuildQuery(t *testing.T) {
+	filters := Filters{}
+	filters.Add("event.since", FilterTypeEq, "7d")
+
+	assert.Equal(t, "", buildQuery(url.Values{}, nil))
+	assert.Equal(t, "?sort=users", buildQuery(url.Values{"sort": {"users"}}, nil))
+	assert.Equal(
+		t,
+		"?sort=users&filters%5Bevent.since%5D%5B%5D%5Btype%5D=eq&filters%5Bevent.since%5D%5B%5D%5Bvalue%5D=7d",
+		buildQuery(url.Values{"sort": {"users"}}, filters),
+	)
+}
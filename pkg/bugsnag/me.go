@@ -8,6 +8,7 @@ import (
 
 // Me struct holds response from /user endpoint.
 type Me struct {
+	ID    string `json:"id"`
 	Name  string `json:"displayName"`
 	Login string `json:"email"`
 }
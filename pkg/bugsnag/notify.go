@@ -0,0 +1,155 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// NotifyPayloadVersion is the version of the error reporting payload.
+	NotifyPayloadVersion = "5"
+
+	// NotifierName is the name this library reports payloads with.
+	NotifierName = "bugsnag-data-cli"
+	// NotifierURL is the homepage of this library.
+	NotifierURL = "https://github.com/teamupstart/bugsnag-data-cli"
+
+	// SeverityError is the severity of unhandled errors.
+	SeverityError = "error"
+	// SeverityWarning is the default severity of handled errors.
+	SeverityWarning = "warning"
+	// SeverityInfo is the severity of informational events.
+	SeverityInfo = "info"
+)
+
+// Notification is a request body of the error reporting api.
+type Notification struct {
+	APIKey   string         `json:"apiKey"`
+	Notifier *Notifier      `json:"notifier"`
+	Events   []*NotifyEvent `json:"events"`
+}
+
+// Notifier identifies the library sending a notification.
+type Notifier struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	URL     string `json:"url"`
+}
+
+// DefaultNotifier identifies this library, at the given version, as the sender of a payload.
+func DefaultNotifier(version string) *Notifier {
+	return &Notifier{Name: NotifierName, Version: version, URL: NotifierURL}
+}
+
+// NotifyEvent is an event sent to the error reporting api.
+type NotifyEvent struct {
+	Exceptions     []*NotifyException                `json:"exceptions"`
+	Context        string                            `json:"context,omitempty"`
+	Severity       string                            `json:"severity,omitempty"`
+	SeverityReason *SeverityReason                   `json:"severityReason,omitempty"`
+	Unhandled      bool                              `json:"unhandled"`
+	User           *NotifyUser                       `json:"user,omitempty"`
+	App            *NotifyApp                        `json:"app,omitempty"`
+	MetaData       map[string]map[string]interface{} `json:"metaData,omitempty"`
+}
+
+// NotifyException is an exception of an event sent to the error reporting api.
+type NotifyException struct {
+	ErrorClass string         `json:"errorClass"`
+	Message    string         `json:"message,omitempty"`
+	Stacktrace []*NotifyFrame `json:"stacktrace"`
+}
+
+// NotifyFrame is a stack frame of an exception sent to the error reporting api.
+type NotifyFrame struct {
+	File       string `json:"file"`
+	LineNumber int    `json:"lineNumber"`
+	Method     string `json:"method"`
+	InProject  bool   `json:"inProject,omitempty"`
+}
+
+// SeverityReason tells why an event has its severity.
+type SeverityReason struct {
+	Type string `json:"type"`
+}
+
+// NotifyUser is the user affected by an event.
+type NotifyUser struct {
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// NotifyApp is the app an event happened in.
+type NotifyApp struct {
+	Version      string `json:"version,omitempty"`
+	ReleaseStage string `json:"releaseStage,omitempty"`
+}
+
+// Validate checks that the notification can be accepted by the error reporting api.
+func (n *Notification) Validate() error {
+	if n.APIKey == "" {
+		return fmt.Errorf("bugsnag: notification api key is required")
+	}
+	if n.Notifier == nil || n.Notifier.Name == "" {
+		return fmt.Errorf("bugsnag: notification notifier name is required")
+	}
+	if len(n.Events) == 0 {
+		return fmt.Errorf("bugsnag: notification needs at least one event")
+	}
+	for i, e := range n.Events {
+		if len(e.Exceptions) == 0 {
+			return fmt.Errorf("bugsnag: event %d needs at least one exception", i)
+		}
+		for _, ex := range e.Exceptions {
+			if ex.ErrorClass == "" {
+				return fmt.Errorf("bugsnag: event %d has an exception without an error class", i)
+			}
+		}
+		switch e.Severity {
+		case "", SeverityError, SeverityWarning, SeverityInfo:
+		default:
+			return fmt.Errorf("bugsnag: event %d has invalid severity %q, must be one of: error, warning, info", i, e.Severity)
+		}
+	}
+	return nil
+}
+
+// Notify sends events to the error reporting api.
+func (c *Client) Notify(n *Notification) error {
+	if err := n.Validate(); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	return c.NotifyRaw(n.APIKey, b)
+}
+
+// NotifyRaw sends a json encoded notification payload as is to the error reporting api.
+func (c *Client) NotifyRaw(apiKey string, payload []byte) error {
+	return c.postPayload(c.notify_endpoint, apiKey, NotifyPayloadVersion, payload)
+}
+
+// postPayload sends a payload to one of the apis authenticated with a project's
+// notifier api key, ie: the error reporting and session tracking apis.
+func (c *Client) postPayload(endpoint, apiKey, version string, payload []byte) error {
+	if apiKey == "" {
+		return fmt.Errorf("bugsnag: api key is required")
+	}
+
+	res, err := c.send(context.Background(), http.MethodPost, endpoint, false, json.RawMessage(payload), Header{
+		"Bugsnag-Api-Key":         apiKey,
+		"Bugsnag-Payload-Version": version,
+		"Bugsnag-Sent-At":         time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
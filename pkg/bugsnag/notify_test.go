@@ -0,0 +1,127 @@
+package bugsnag
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testNotification() *Notification {
+	return &Notification{
+		APIKey:   "abc",
+		Notifier: &Notifier{Name: "bugsnag-data-cli", Version: "1.0.0", URL: "https://github.com/teamupstart/bugsnag-data-cli"},
+		Events: []*NotifyEvent{{
+			Exceptions: []*NotifyException{{ErrorClass: "TestError", Message: "it works", Stacktrace: []*NotifyFrame{}}},
+			Severity:   SeverityWarning,
+			App:        &NotifyApp{Version: "2.4.1"},
+		}},
+	}
+}
+
+func TestNotify(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "abc", r.Header.Get("Bugsnag-Api-Key"))
+		assert.Equal(t, NotifyPayloadVersion, r.Header.Get("Bugsnag-Payload-Version"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		_, err := time.Parse(time.RFC3339, r.Header.Get("Bugsnag-Sent-At"))
+		assert.NoError(t, err)
+
+		var body struct {
+			APIKey string `json:"apiKey"`
+			Events []struct {
+				Exceptions []struct {
+					ErrorClass string        `json:"errorClass"`
+					Stacktrace []interface{} `json:"stacktrace"`
+				} `json:"exceptions"`
+				Severity  string `json:"severity"`
+				Unhandled bool   `json:"unhandled"`
+			} `json:"events"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "abc", body.APIKey)
+		assert.Equal(t, "TestError", body.Events[0].Exceptions[0].ErrorClass)
+		assert.NotNil(t, body.Events[0].Exceptions[0].Stacktrace)
+		assert.Equal(t, "warning", body.Events[0].Severity)
+
+		w.WriteHeader(202)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{NotifyEndpoint: server.URL, APIToken: "secret"})
+
+	assert.NoError(t, client.Notify(testNotification()))
+}
+
+func TestNotifyErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+		_, _ = w.Write([]byte(`{"errors":["payload is invalid"]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{NotifyEndpoint: server.URL})
+
+	err := client.NotifyRaw("abc", []byte(`{}`))
+	assert.IsType(t, &ErrUnexpectedResponse{}, err)
+	assert.Contains(t, err.Error(), "payload is invalid")
+
+	assert.Error(t, client.NotifyRaw("", []byte(`{}`)))
+}
+
+func TestNotificationValidate(t *testing.T) {
+	cases := []struct {
+		name   string
+		modify func(*Notification)
+		err    string
+	}{
+		{name: "it accepts a valid notification", modify: func(*Notification) {}},
+		{
+			name:   "it requires api key",
+			modify: func(n *Notification) { n.APIKey = "" },
+			err:    "bugsnag: notification api key is required",
+		},
+		{
+			name:   "it requires events",
+			modify: func(n *Notification) { n.Events = nil },
+			err:    "bugsnag: notification needs at least one event",
+		},
+		{
+			name:   "it requires error class",
+			modify: func(n *Notification) { n.Events[0].Exceptions[0].ErrorClass = "" },
+			err:    "bugsnag: event 0 has an exception without an error class",
+		},
+		{
+			name:   "it validates severity",
+			modify: func(n *Notification) { n.Events[0].Severity = "fatal" },
+			err:    `bugsnag: event 0 has invalid severity "fatal", must be one of: error, warning, info`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			n := testNotification()
+			tc.modify(n)
+
+			err := n.Validate()
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestNewClientNotifyEndpoint(t *testing.T) {
+	assert.Equal(t, DefaultNotifyEndpoint, NewClient(Config{}).notify_endpoint)
+}
@@ -0,0 +1,62 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Organization holds response from /organizations endpoint.
+type Organization struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Slug             string   `json:"slug"`
+	Creator          *User    `json:"creator,omitempty"`
+	CollaboratorsURL string   `json:"collaborators_url"`
+	ProjectsURL      string   `json:"projects_url"`
+	UpgradeURL       string   `json:"upgrade_url"`
+	AutoUpgrade      bool     `json:"auto_upgrade"`
+	BillingEmails    []string `json:"billing_emails"`
+	CreatedAt        string   `json:"created_at"`
+	UpdatedAt        string   `json:"updated_at"`
+}
+
+// User is a minimal representation of a bugsnag user.
+type User struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// OrganizationsPager returns a pager over /user/organizations endpoint.
+func (c *Client) OrganizationsPager(opts *ListOptions) *Pager[*Organization] {
+	return newPager[*Organization](c, "/user/organizations", nil, nil, opts)
+}
+
+// ListOrganizations fetches all pages from /user/organizations endpoint.
+func (c *Client) ListOrganizations() ([]*Organization, error) {
+	return Collect(context.Background(), c.OrganizationsPager(nil), 0)
+}
+
+// GetOrganization fetches response from /organizations/{id} endpoint.
+func (c *Client) GetOrganization(id string) (*Organization, error) {
+	res, err := c.Get(context.Background(), "/organizations/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Organization
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
@@ -0,0 +1,63 @@
+package bugsnag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListOrganizations(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/user/organizations", r.URL.Path)
+		assert.Equal(t, "token secret", r.Header.Get("Authorization"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/organizations.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.ListOrganizations()
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "5f0c1a2b3c4d5e6f7a8b9c0d", actual[0].ID)
+	assert.Equal(t, "upstart", actual[0].Slug)
+	assert.Equal(t, "Jane Doe", actual[0].Creator.Name)
+	assert.Nil(t, actual[1].Creator)
+
+	unexpectedStatusCode = true
+
+	_, err = client.ListOrganizations()
+	assert.IsType(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetOrganization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/organizations/5f0c1a2b3c4d5e6f7a8b9c0e", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "5f0c1a2b3c4d5e6f7a8b9c0e", "name": "Upstart Labs", "slug": "upstart-labs"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.GetOrganization("5f0c1a2b3c4d5e6f7a8b9c0e")
+	assert.NoError(t, err)
+	assert.Equal(t, "Upstart Labs", actual.Name)
+}
@@ -0,0 +1,211 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultPerPage is the page size used by the api if none is requested.
+	DefaultPerPage = 30
+	// MaxPerPage is the maximum page size supported by the api.
+	MaxPerPage = 100
+
+	headerTotalCount = "X-Total-Count"
+)
+
+// ErrNoMorePages is returned when Next is called on an exhausted pager.
+var ErrNoMorePages = fmt.Errorf("bugsnag: no more pages")
+
+var linkRegex = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?([^",]+)"?`)
+
+// ListOptions holds pagination options for list endpoints.
+type ListOptions struct {
+	// PerPage is the number of items fetched per request.
+	// Defaults to Limit if it is less than MaxPerPage, else MaxPerPage.
+	PerPage int
+	// Limit caps the total number of items collected, 0 means no limit.
+	Limit int
+}
+
+func (o *ListOptions) perPage() int {
+	if o == nil {
+		return MaxPerPage
+	}
+	if o.PerPage > 0 {
+		return o.PerPage
+	}
+	if o.Limit > 0 && o.Limit < MaxPerPage {
+		return o.Limit
+	}
+	return MaxPerPage
+}
+
+// Pager iterates over pages of a list endpoint by following
+// the rel="next" links returned in the Link response header.
+type Pager[T any] struct {
+	client *Client
+	next   string
+	total  int
+}
+
+func newPager[T any](c *Client, path string, params url.Values, filters Filters, opts *ListOptions) *Pager[T] {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("per_page", strconv.Itoa(opts.perPage()))
+
+	return &Pager[T]{
+		client: c,
+		next:   c.api_endpoint + path + buildQuery(params, filters),
+		total:  -1,
+	}
+}
+
+// HasNext reports whether there are more pages to fetch.
+func (p *Pager[T]) HasNext() bool {
+	return p.next != ""
+}
+
+// Total returns the total number of items as reported by the X-Total-Count
+// header. It returns -1 if no page is fetched yet or the header is missing.
+func (p *Pager[T]) Total() int {
+	return p.total
+}
+
+// Next fetches the next page of items.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	if !p.HasNext() {
+		return nil, ErrNoMorePages
+	}
+
+	res, err := p.client.request(ctx, http.MethodGet, p.next, true, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	if n, err := strconv.Atoi(res.Header.Get(headerTotalCount)); err == nil {
+		p.total = n
+	}
+
+	next, err := p.client.nextLink(res.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []T
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	p.next = next
+
+	return out, nil
+}
+
+// Cursor returns path of the next page relative to the api endpoint. It can be
+// passed to Seek to resume iteration later. It is empty once the pager is exhausted.
+func (p *Pager[T]) Cursor() (string, error) {
+	if !p.HasNext() {
+		return "", nil
+	}
+	return p.client.relativePath(p.next)
+}
+
+// Seek moves the pager to the page pointed by a cursor returned from Cursor.
+func (p *Pager[T]) Seek(cursor string) error {
+	if !strings.HasPrefix(cursor, "/") {
+		return fmt.Errorf("bugsnag: invalid cursor %q", cursor)
+	}
+	p.next = p.client.api_endpoint + cursor
+	return nil
+}
+
+// Collect fetches pages from p until it is exhausted or limit items are
+// collected. Everything is collected if limit is less than one.
+func Collect[T any](ctx context.Context, p *Pager[T], limit int) ([]T, error) {
+	var out []T
+
+	for p.HasNext() {
+		items, err := p.Next(ctx)
+		if err != nil {
+			return out, err
+		}
+		out = append(out, items...)
+
+		if limit > 0 && len(out) >= limit {
+			return out[:limit], nil
+		}
+	}
+
+	return out, nil
+}
+
+// NextLink returns path of the next page, relative to the api endpoint,
+// from the Link header of the response. It is empty on the last page.
+func (c *Client) NextLink(res *http.Response) (string, error) {
+	next, err := c.nextLink(res.Header)
+	if err != nil || next == "" {
+		return "", err
+	}
+	return c.relativePath(next)
+}
+
+// relativePath strips the api endpoint from an absolute url.
+func (c *Client) relativePath(next string) (string, error) {
+	base, err := url.Parse(c.api_endpoint)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+
+	path := strings.TrimPrefix(u.EscapedPath(), strings.TrimSuffix(base.EscapedPath(), "/"))
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+	return path, nil
+}
+
+// nextLink extracts rel="next" url from the Link headers. Relative links are resolved
+// against the api endpoint and links to other hosts are rejected so that the token
+// is never sent elsewhere.
+func (c *Client) nextLink(h http.Header) (string, error) {
+	for _, v := range h.Values("Link") {
+		for _, m := range linkRegex.FindAllStringSubmatch(v, -1) {
+			if m[2] != "next" {
+				continue
+			}
+
+			base, err := url.Parse(c.api_endpoint + "/")
+			if err != nil {
+				return "", err
+			}
+			u, err := base.Parse(m[1])
+			if err != nil {
+				return "", err
+			}
+			if u.Host != base.Host {
+				return "", fmt.Errorf("bugsnag: refusing to follow pagination link to %q", u.Host)
+			}
+			return u.String(), nil
+		}
+	}
+	return "", nil
+}
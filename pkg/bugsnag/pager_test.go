@@ -0,0 +1,154 @@
+package bugsnag
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pagedServer(t *testing.T, pages int, link func(page int) string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/organizations/org/projects", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("per_page"))
+
+		var page int
+		_, _ = fmt.Sscanf(r.URL.Query().Get("page"), "%d", &page)
+		if page == 0 {
+			page = 1
+		}
+
+		if page < pages {
+			w.Header().Set("Link", link(page+1))
+		}
+		w.Header().Set("X-Total-Count", fmt.Sprintf("%d", pages*2))
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintf(w, `[{"id": "p%d-1"}, {"id": "p%d-2"}]`, page, page)
+	}))
+}
+
+func TestPager(t *testing.T) {
+	var server *httptest.Server
+	server = pagedServer(t, 3, func(page int) string {
+		return fmt.Sprintf(`<%s/organizations/org/projects?per_page=2&page=%d>; rel="next"`, server.URL, page)
+	})
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+	pager := client.ProjectsPager("org", &ListOptions{PerPage: 2})
+
+	assert.True(t, pager.HasNext())
+	assert.Equal(t, -1, pager.Total())
+
+	var ids []string
+	for pager.HasNext() {
+		items, err := pager.Next(context.Background())
+		assert.NoError(t, err)
+
+		for _, p := range items {
+			ids = append(ids, p.ID)
+		}
+	}
+
+	assert.Equal(t, []string{"p1-1", "p1-2", "p2-1", "p2-2", "p3-1", "p3-2"}, ids)
+	assert.Equal(t, 6, pager.Total())
+
+	_, err := pager.Next(context.Background())
+	assert.Equal(t, ErrNoMorePages, err)
+}
+
+func TestCollect(t *testing.T) {
+	server := pagedServer(t, 3, func(page int) string {
+		// Relative links are resolved against the api endpoint.
+		return fmt.Sprintf(`</organizations/org/projects?per_page=2&page=%d>; rel="next", </organizations/org/projects?per_page=2&page=3>; rel="last"`, page)
+	})
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	all, err := Collect(context.Background(), client.ProjectsPager("org", &ListOptions{PerPage: 2}), 0)
+	assert.NoError(t, err)
+	assert.Len(t, all, 6)
+
+	limited, err := Collect(context.Background(), client.ProjectsPager("org", &ListOptions{Limit: 3, PerPage: 2}), 3)
+	assert.NoError(t, err)
+	assert.Len(t, limited, 3)
+	assert.Equal(t, "p2-1", limited[2].ID)
+}
+
+func TestPagerRejectsForeignLinks(t *testing.T) {
+	server := pagedServer(t, 2, func(page int) string {
+		return fmt.Sprintf(`<https://example.com/organizations/org/projects?page=%d>; rel="next"`, page)
+	})
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	_, err := Collect(context.Background(), client.ProjectsPager("org", &ListOptions{PerPage: 2}), 0)
+	assert.Error(t, err)
+}
+
+func TestListOptionsPerPage(t *testing.T) {
+	assert.Equal(t, MaxPerPage, (*ListOptions)(nil).perPage())
+	assert.Equal(t, MaxPerPage, (&ListOptions{}).perPage())
+	assert.Equal(t, 10, (&ListOptions{Limit: 10}).perPage())
+	assert.Equal(t, MaxPerPage, (&ListOptions{Limit: 500}).perPage())
+	assert.Equal(t, 25, (&ListOptions{Limit: 10, PerPage: 25}).perPage())
+}
+
+func TestNextLink(t *testing.T) {
+	client := NewClient(Config{APIEndpoint: "https://api.bugsnag.com/"})
+
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("Link", `<https://api.bugsnag.com/projects/p/errors?offset=abc&per_page=30>; rel="next"`)
+
+	next, err := client.NextLink(res)
+	assert.NoError(t, err)
+	assert.Equal(t, "/projects/p/errors?offset=abc&per_page=30", next)
+
+	res.Header.Set("Link", `<https://api.bugsnag.com/projects/p/errors?offset=abc>; rel="prev"`)
+
+	next, err = client.NextLink(res)
+	assert.NoError(t, err)
+	assert.Equal(t, "", next)
+
+	res.Header.Set("Link", `<https://evil.example.com/projects/p/errors>; rel="next"`)
+
+	_, err = client.NextLink(res)
+	assert.Error(t, err)
+}
+
+func TestPagerCursor(t *testing.T) {
+	var server *httptest.Server
+	server = pagedServer(t, 3, func(page int) string {
+		return fmt.Sprintf(`<%s/organizations/org/projects?per_page=2&page=%d>; rel="next"`, server.URL, page)
+	})
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+	pager := client.ProjectsPager("org", &ListOptions{PerPage: 2})
+
+	_, err := pager.Next(context.Background())
+	assert.NoError(t, err)
+
+	cursor, err := pager.Cursor()
+	assert.NoError(t, err)
+	assert.Equal(t, "/organizations/org/projects?per_page=2&page=2", cursor)
+
+	resumed := client.ProjectsPager("org", &ListOptions{PerPage: 2})
+	assert.NoError(t, resumed.Seek(cursor))
+
+	items, err := Collect(context.Background(), resumed, 0)
+	assert.NoError(t, err)
+	assert.Len(t, items, 4)
+	assert.Equal(t, "p2-1", items[0].ID)
+
+	cursor, err = resumed.Cursor()
+	assert.NoError(t, err)
+	assert.Empty(t, cursor)
+
+	assert.Error(t, resumed.Seek("https://evil.example.com/organizations"))
+}
@@ -0,0 +1,75 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Pivot holds response from /projects/{project_id}/pivots endpoint.
+type Pivot struct {
+	EventFieldDisplayID string        `json:"event_field_display_id"`
+	Name                string        `json:"name"`
+	Cardinality         int           `json:"cardinality"`
+	Summary             *PivotSummary `json:"summary,omitempty"`
+}
+
+// PivotSummary holds the most common values of a pivot.
+type PivotSummary struct {
+	List  []*PivotValue `json:"list"`
+	Other int           `json:"other"`
+}
+
+// PivotValue is a value of an event field along with the number of events it was seen in.
+type PivotValue struct {
+	Value     string `json:"value"`
+	Events    int    `json:"events"`
+	FirstSeen string `json:"first_seen,omitempty"`
+	LastSeen  string `json:"last_seen,omitempty"`
+}
+
+// ListPivots fetches pivotable fields of an error, or of the whole project if errorID is empty.
+func (c *Client) ListPivots(projectID, errorID string, filters Filters) ([]*Pivot, error) {
+	res, err := c.Get(context.Background(), pivotsPath(projectID, errorID)+buildQuery(nil, filters), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Pivot
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// PivotValuesPager returns a pager over values of an event field, most common first.
+func (c *Client) PivotValuesPager(projectID, errorID, field string, filters Filters, opts *ListOptions) *Pager[*PivotValue] {
+	path := pivotsPath(projectID, errorID) + "/" + url.PathEscape(field) + "/values"
+	return newPager[*PivotValue](c, path, url.Values{"sort": {"events"}}, filters, opts)
+}
+
+// GetPivotValues fetches values of an event field until opts.Limit values are collected.
+func (c *Client) GetPivotValues(projectID, errorID, field string, filters Filters, opts *ListOptions) ([]*PivotValue, error) {
+	var limit int
+	if opts != nil {
+		limit = opts.Limit
+	}
+	return Collect(context.Background(), c.PivotValuesPager(projectID, errorID, field, filters, opts), limit)
+}
+
+func pivotsPath(projectID, errorID string) string {
+	path := "/projects/" + url.PathEscape(projectID)
+	if errorID != "" {
+		path += "/errors/" + url.PathEscape(errorID)
+	}
+	return path + "/pivots"
+}
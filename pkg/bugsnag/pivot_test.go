@@ -0,0 +1,52 @@
+package bugsnag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPivots(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/p1/errors/e1/pivots", r.URL.Path)
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[{
+			"event_field_display_id": "device.os_name",
+			"name": "Operating system",
+			"cardinality": 2,
+			"summary": {"list": [{"value": "iOS", "events": 30}], "other": 4}
+		}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.ListPivots("p1", "e1", nil)
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, "device.os_name", actual[0].EventFieldDisplayID)
+	assert.Equal(t, "iOS", actual[0].Summary.List[0].Value)
+	assert.Equal(t, 4, actual[0].Summary.Other)
+}
+
+func TestGetPivotValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/p1/pivots/app.version/values", r.URL.Path)
+		assert.Equal(t, "events", r.URL.Query().Get("sort"))
+		assert.Equal(t, "2", r.URL.Query().Get("per_page"))
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[{"value": "1.4.2", "events": 120}, {"value": "1.4.1", "events": 3}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.GetPivotValues("p1", "", "app.version", nil, &ListOptions{Limit: 2})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, 120, actual[0].Events)
+}
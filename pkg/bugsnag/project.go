@@ -0,0 +1,77 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Project holds response from /projects endpoint.
+type Project struct {
+	ID                   string   `json:"id"`
+	OrganizationID       string   `json:"organization_id"`
+	Slug                 string   `json:"slug"`
+	Name                 string   `json:"name"`
+	APIKey               string   `json:"api_key"`
+	Type                 string   `json:"type"`
+	Language             string   `json:"language"`
+	IsFullView           bool     `json:"is_full_view"`
+	ReleaseStages        []string `json:"release_stages"`
+	OpenErrorCount       int      `json:"open_error_count"`
+	ForReviewErrorCount  int      `json:"for_review_error_count"`
+	CollaboratorsCount   int      `json:"collaborators_count"`
+	GlobalGrouping       []string `json:"global_grouping"`
+	LocationGrouping     []string `json:"location_grouping"`
+	DiscardedAppVersions []string `json:"discarded_app_versions"`
+	DiscardedErrors      []string `json:"discarded_errors"`
+	ResolveOnDeploy      bool     `json:"resolve_on_deploy"`
+	// StabilityTargetType is either "user" or "session".
+	StabilityTargetType string           `json:"stability_target_type,omitempty"`
+	TargetStability     *StabilityTarget `json:"target_stability,omitempty"`
+	CriticalStability   *StabilityTarget `json:"critical_stability,omitempty"`
+	URL                 string           `json:"url"`
+	HTMLURL             string           `json:"html_url"`
+	ErrorsURL           string           `json:"errors_url"`
+	EventsURL           string           `json:"events_url"`
+	CreatedAt           string           `json:"created_at"`
+	UpdatedAt           string           `json:"updated_at"`
+}
+
+// StabilityTarget is a stability score of a project, as a fraction between 0 and 1.
+type StabilityTarget struct {
+	Value     float64 `json:"value"`
+	UpdatedAt string  `json:"updated_at,omitempty"`
+}
+
+// ProjectsPager returns a pager over /organizations/{orgID}/projects endpoint.
+func (c *Client) ProjectsPager(orgID string, opts *ListOptions) *Pager[*Project] {
+	return newPager[*Project](c, "/organizations/"+url.PathEscape(orgID)+"/projects", nil, nil, opts)
+}
+
+// ListProjects fetches all pages from /organizations/{orgID}/projects endpoint.
+func (c *Client) ListProjects(orgID string) ([]*Project, error) {
+	return Collect(context.Background(), c.ProjectsPager(orgID, nil), 0)
+}
+
+// GetProject fetches response from /projects/{id} endpoint.
+func (c *Client) GetProject(id string) (*Project, error) {
+	res, err := c.Get(context.Background(), "/projects/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Project
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
@@ -0,0 +1,49 @@
+package bugsnag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListProjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/organizations/5f0c1a2b3c4d5e6f7a8b9c0d/projects", r.URL.Path)
+
+		resp, err := os.ReadFile("./testdata/projects.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.ListProjects("5f0c1a2b3c4d5e6f7a8b9c0d")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, "web-app", actual[0].Slug)
+	assert.Equal(t, []string{"production", "staging"}, actual[0].ReleaseStages)
+	assert.Equal(t, 42, actual[0].OpenErrorCount)
+}
+
+func TestGetProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/60a1b2c3d4e5f6a7b8c9d0e1", r.URL.Path)
+
+		w.WriteHeader(404)
+		_, _ = w.Write([]byte(`{"errors": {"project": "not found"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	_, err := client.GetProject("60a1b2c3d4e5f6a7b8c9d0e1")
+	assert.IsType(t, &ErrUnexpectedResponse{}, err)
+	assert.Equal(t, 404, err.(*ErrUnexpectedResponse).StatusCode)
+}
@@ -0,0 +1,104 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// StabilityTargetUser measures stability as the share of users without unhandled errors.
+	StabilityTargetUser = "user"
+	// StabilityTargetSession measures stability as the share of sessions without unhandled errors.
+	StabilityTargetSession = "session"
+)
+
+// Release holds response from /projects/{project_id}/releases endpoint.
+type Release struct {
+	ID                                  string         `json:"id"`
+	ProjectID                           string         `json:"project_id"`
+	ReleaseTime                         string         `json:"release_time"`
+	ReleaseSource                       string         `json:"release_source"`
+	AppVersion                          string         `json:"app_version"`
+	AppVersionCode                      string         `json:"app_version_code,omitempty"`
+	AppBundleVersion                    string         `json:"app_bundle_version,omitempty"`
+	ReleaseStage                        string         `json:"release_stage"`
+	BuilderName                         string         `json:"builder_name,omitempty"`
+	SourceControl                       *SourceControl `json:"source_control,omitempty"`
+	ErrorsIntroducedCount               int            `json:"errors_introduced_count"`
+	ErrorsSeenCount                     int            `json:"errors_seen_count"`
+	SessionsCountInLast24h              int            `json:"sessions_count_in_last_24h"`
+	TotalSessionsCount                  int            `json:"total_sessions_count"`
+	UnhandledSessionsCount              int            `json:"unhandled_sessions_count"`
+	AccumulativeDailyUsersSeen          int            `json:"accumulative_daily_users_seen"`
+	AccumulativeDailyUsersWithUnhandled int            `json:"accumulative_daily_users_with_unhandled"`
+}
+
+// SourceControl holds the revision a release was built from.
+type SourceControl struct {
+	Provider          string `json:"provider,omitempty"`
+	Repository        string `json:"repository"`
+	Revision          string `json:"revision"`
+	DiffURLToPrevious string `json:"diff_url_to_previous,omitempty"`
+}
+
+// CrashFreeSessions returns the share of sessions without unhandled errors.
+// It returns false if no sessions were tracked.
+func (r *Release) CrashFreeSessions() (float64, bool) {
+	return crashFree(r.UnhandledSessionsCount, r.TotalSessionsCount)
+}
+
+// CrashFreeUsers returns the share of users without unhandled errors.
+// It returns false if no users were tracked.
+func (r *Release) CrashFreeUsers() (float64, bool) {
+	return crashFree(r.AccumulativeDailyUsersWithUnhandled, r.AccumulativeDailyUsersSeen)
+}
+
+// ReleasesPager returns a pager over releases of a project, newest first. All
+// release stages are included if stage is empty.
+func (c *Client) ReleasesPager(projectID, stage string, opts *ListOptions) *Pager[*Release] {
+	params := url.Values{}
+	if stage != "" {
+		params.Set("release_stage", stage)
+	}
+	return newPager[*Release](c, "/projects/"+url.PathEscape(projectID)+"/releases", params, nil, opts)
+}
+
+// ListReleases fetches releases of a project until opts.Limit releases are collected.
+func (c *Client) ListReleases(projectID, stage string, opts *ListOptions) ([]*Release, error) {
+	var limit int
+	if opts != nil {
+		limit = opts.Limit
+	}
+	return Collect(context.Background(), c.ReleasesPager(projectID, stage, opts), limit)
+}
+
+// GetRelease fetches response from /releases/{releaseID} endpoint.
+func (c *Client) GetRelease(releaseID string) (*Release, error) {
+	res, err := c.Get(context.Background(), "/releases/"+url.PathEscape(releaseID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Release
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+func crashFree(unhandled, total int) (float64, bool) {
+	if total <= 0 {
+		return 0, false
+	}
+	return 1 - float64(unhandled)/float64(total), true
+}
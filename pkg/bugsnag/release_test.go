@@ -0,0 +1,70 @@
+package bugsnag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListReleases(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/60a1b2c3d4e5f6a7b8c9d0e1/releases", r.URL.Path)
+		assert.Equal(t, "production", r.URL.Query().Get("release_stage"))
+
+		resp, err := os.ReadFile("./testdata/releases.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.ListReleases("60a1b2c3d4e5f6a7b8c9d0e1", "production", nil)
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "2.4.1", actual[0].AppVersion)
+	assert.Equal(t, "9f2c4e1a7b3d5f6e8a0c2b4d6f8e0a1c3b5d7f9e", actual[0].SourceControl.Revision)
+	assert.Nil(t, actual[1].SourceControl)
+
+	sessions, ok := actual[0].CrashFreeSessions()
+	assert.True(t, ok)
+	assert.InDelta(t, 0.995, sessions, 1e-9)
+
+	users, ok := actual[0].CrashFreeUsers()
+	assert.True(t, ok)
+	assert.InDelta(t, 0.985, users, 1e-9)
+
+	_, ok = actual[1].CrashFreeSessions()
+	assert.False(t, ok)
+}
+
+func TestGetRelease(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/releases/63c1d2e3f4a5b6c7d8e9f0a1" {
+			w.WriteHeader(404)
+			_, _ = w.Write([]byte(`{"errors":["Not found"]}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id":"63c1d2e3f4a5b6c7d8e9f0a1","app_version":"2.4.1","release_stage":"production"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.GetRelease("63c1d2e3f4a5b6c7d8e9f0a1")
+	assert.NoError(t, err)
+	assert.Equal(t, "2.4.1", actual.AppVersion)
+
+	_, err = client.GetRelease("63c1d2e3f4a5b6c7d8e9f0a9")
+	assert.Error(t, err)
+	assert.IsType(t, &ErrUnexpectedResponse{}, err)
+}
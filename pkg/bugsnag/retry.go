@@ -0,0 +1,136 @@
+package bugsnag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// DefaultRetryPolicy is the retry policy used by the cli.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+}
+
+// RetryPolicy configures how failed requests are retried.
+//
+// Requests are retried on 429 responses. Idempotent requests are
+// also retried on 5xx responses and on connection resets.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values less than 2 disable retries.
+	MaxAttempts int
+	// MinBackoff is the wait before the first retry. It doubles on each
+	// subsequent retry and a random jitter of up to half of it is applied.
+	MinBackoff time.Duration
+	// MaxBackoff caps the exponential backoff and the wait requested by Retry-After.
+	MaxBackoff time.Duration
+}
+
+// WithRetryPolicy is a functional opt to retry failed requests.
+func WithRetryPolicy(p RetryPolicy) ClientFunc {
+	return func(c *Client) {
+		c.retryPolicy = p
+	}
+}
+
+// ShouldRetry returns the time to wait before next attempt and if the request should be retried at all.
+func (p RetryPolicy) ShouldRetry(method string, attempt int, res *http.Response, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	switch {
+	case err != nil:
+		if !isIdempotent(method) || !isConnectionReset(err) {
+			return 0, false
+		}
+	case res.StatusCode == http.StatusTooManyRequests:
+		if wait, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+				wait = p.MaxBackoff
+			}
+			return wait, true
+		}
+	case res.StatusCode >= http.StatusInternalServerError:
+		if !isIdempotent(method) {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	return p.backoff(attempt), true
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.MinBackoff << (attempt - 1)
+	if wait <= 0 || (p.MaxBackoff > 0 && wait > p.MaxBackoff) {
+		wait = p.MaxBackoff
+	}
+	if half := int64(wait / 2); half > 0 {
+		wait = time.Duration(half + rand.Int63n(half+1)) //nolint:gosec
+	}
+	return wait
+}
+
+// retryAfter parses Retry-After header which is either in seconds or a http date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if wait := time.Until(t); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func isConnectionReset(err error) bool {
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "read"
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func retryReason(res *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return fmt.Sprintf("received '%s'", res.Status)
+}
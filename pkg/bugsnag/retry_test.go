@@ -0,0 +1,201 @@
+package bugsnag
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  5 * time.Millisecond,
+}
+
+func TestRequestRetries(t *testing.T) {
+	cases := []struct {
+		name     string
+		method   string
+		statuses []int
+		expected int
+		attempts int
+	}{
+		{
+			name:     "it retries rate limited requests",
+			method:   http.MethodGet,
+			statuses: []int{429, 429, 200},
+			expected: 200,
+			attempts: 3,
+		},
+		{
+			name:     "it retries idempotent requests on server errors",
+			method:   http.MethodGet,
+			statuses: []int{502, 200},
+			expected: 200,
+			attempts: 2,
+		},
+		{
+			name:     "it gives up after max attempts",
+			method:   http.MethodGet,
+			statuses: []int{503, 503, 503, 200},
+			expected: 503,
+			attempts: 3,
+		},
+		{
+			name:     "it doesn't retry non idempotent requests on server errors",
+			method:   http.MethodPost,
+			statuses: []int{500, 200},
+			expected: 500,
+			attempts: 1,
+		},
+		{
+			name:     "it retries non idempotent requests when rate limited",
+			method:   http.MethodPost,
+			statuses: []int{429, 201},
+			expected: 201,
+			attempts: 2,
+		},
+		{
+			name:     "it doesn't retry client errors",
+			method:   http.MethodGet,
+			statuses: []int{404, 200},
+			expected: 404,
+			attempts: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts int
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tc.statuses[attempts] == http.StatusTooManyRequests {
+					w.Header().Set("Retry-After", "0")
+				}
+				w.WriteHeader(tc.statuses[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			client := NewClient(Config{APIEndpoint: server.URL}, WithRetryPolicy(testRetryPolicy))
+
+			res, err := client.request(context.Background(), tc.method, server.URL+"/user", true, []byte("{}"), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, res.StatusCode)
+			assert.Equal(t, tc.attempts, attempts)
+		})
+	}
+}
+
+func TestRequestRetryHonorsContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	p := testRetryPolicy
+	p.MaxBackoff = time.Minute
+
+	client := NewClient(Config{APIEndpoint: server.URL}, WithRetryPolicy(p))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.Get(ctx, "/user", nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, max := range map[int]time.Duration{1: 100, 2: 200, 3: 400, 4: 800, 5: 1000, 10: 1000} {
+		max *= time.Millisecond
+
+		wait := p.backoff(attempt)
+		assert.GreaterOrEqual(t, wait, max/2)
+		assert.LessOrEqual(t, wait, max)
+	}
+}
+
+func TestRetryPolicyCapsRetryAfter(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 3, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	cases := []struct {
+		name       string
+		retryAfter string
+		expected   time.Duration
+	}{
+		{name: "it waits as requested below max backoff", retryAfter: "0", expected: 0},
+		{name: "it caps long waits at max backoff", retryAfter: "3600", expected: time.Second},
+		{
+			name:       "it caps http dates at max backoff",
+			retryAfter: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat),
+			expected:   time.Second,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+			res.Header.Set("Retry-After", tc.retryAfter)
+
+			wait, ok := p.ShouldRetry(http.MethodGet, 1, res, nil)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, wait)
+		})
+	}
+}
+
+func TestRequestRetryAfterIsCapped(t *testing.T) {
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL}, WithRetryPolicy(testRetryPolicy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := client.Get(ctx, "/user", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryAfter(t *testing.T) {
+	wait, ok := retryAfter("3")
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, wait)
+
+	wait, ok = retryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.InDelta(t, time.Minute, wait, float64(2*time.Second))
+
+	_, ok = retryAfter("")
+	assert.False(t, ok)
+
+	_, ok = retryAfter("soon")
+	assert.False(t, ok)
+}
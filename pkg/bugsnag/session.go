@@ -0,0 +1,89 @@
+package bugsnag
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SessionsPayloadVersion is the version of the session tracking payload.
+const SessionsPayloadVersion = "1.0"
+
+// SessionsPayload is a request body of the session tracking api with
+// the number of sessions started per minute.
+type SessionsPayload struct {
+	Notifier      *Notifier       `json:"notifier"`
+	App           *NotifyApp      `json:"app"`
+	Device        *SessionDevice  `json:"device,omitempty"`
+	SessionCounts []*SessionCount `json:"sessionCounts"`
+}
+
+// SessionDevice is the device sessions were started on.
+type SessionDevice struct {
+	Hostname  string `json:"hostname,omitempty"`
+	OSName    string `json:"osName,omitempty"`
+	OSVersion string `json:"osVersion,omitempty"`
+}
+
+// SessionCount is the number of sessions started in a minute.
+type SessionCount struct {
+	// StartedAt is the start of the minute in RFC3339 format.
+	StartedAt       string `json:"startedAt"`
+	SessionsStarted int    `json:"sessionsStarted"`
+}
+
+// NewSessionCount returns count of sessions started in the minute of t.
+func NewSessionCount(t time.Time, sessions int) *SessionCount {
+	return &SessionCount{
+		StartedAt:       t.UTC().Truncate(time.Minute).Format(time.RFC3339),
+		SessionsStarted: sessions,
+	}
+}
+
+// Validate checks that the payload can be accepted by the session tracking api.
+func (p *SessionsPayload) Validate() error {
+	if p.Notifier == nil || p.Notifier.Name == "" {
+		return fmt.Errorf("bugsnag: sessions notifier name is required")
+	}
+	if p.App == nil || p.App.Version == "" {
+		return fmt.Errorf("bugsnag: sessions app version is required")
+	}
+	if p.App.ReleaseStage == "" {
+		return fmt.Errorf("bugsnag: sessions release stage is required")
+	}
+	if len(p.SessionCounts) == 0 {
+		return fmt.Errorf("bugsnag: sessions payload needs at least one session count")
+	}
+
+	seen := make(map[string]struct{}, len(p.SessionCounts))
+	for _, c := range p.SessionCounts {
+		t, err := time.Parse(time.RFC3339, c.StartedAt)
+		if err != nil {
+			return fmt.Errorf("bugsnag: invalid session start time %q, must be in RFC3339 format", c.StartedAt)
+		}
+		if t.Second() != 0 || t.Nanosecond() != 0 {
+			return fmt.Errorf("bugsnag: session start time %q must be the start of a minute", c.StartedAt)
+		}
+		if c.SessionsStarted < 1 {
+			return fmt.Errorf("bugsnag: session count at %s must be positive", c.StartedAt)
+		}
+		if _, ok := seen[c.StartedAt]; ok {
+			return fmt.Errorf("bugsnag: duplicate session count at %s", c.StartedAt)
+		}
+		seen[c.StartedAt] = struct{}{}
+	}
+	return nil
+}
+
+// SendSessions reports session counts to the session tracking api.
+func (c *Client) SendSessions(apiKey string, p *SessionsPayload) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return c.postPayload(c.sessions_endpoint, apiKey, SessionsPayloadVersion, b)
+}
@@ -0,0 +1,121 @@
+package bugsnag
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testSessionsPayload() *SessionsPayload {
+	return &SessionsPayload{
+		Notifier: DefaultNotifier("1.0.0"),
+		App:      &NotifyApp{Version: "2.4.1", ReleaseStage: "production"},
+		SessionCounts: []*SessionCount{
+			{StartedAt: "2022-07-12T10:00:00Z", SessionsStarted: 40},
+			{StartedAt: "2022-07-12T10:01:00Z", SessionsStarted: 12},
+		},
+	}
+}
+
+func TestSendSessions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "abc", r.Header.Get("Bugsnag-Api-Key"))
+		assert.Equal(t, SessionsPayloadVersion, r.Header.Get("Bugsnag-Payload-Version"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		var body SessionsPayload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, testSessionsPayload(), &body)
+
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(400)
+			return
+		}
+		w.WriteHeader(202)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{SessionsEndpoint: server.URL, APIToken: "secret"})
+
+	assert.NoError(t, client.SendSessions("abc", testSessionsPayload()))
+	assert.Error(t, client.SendSessions("", testSessionsPayload()))
+
+	client = NewClient(Config{SessionsEndpoint: server.URL + "/fail"})
+
+	assert.IsType(t, &ErrUnexpectedResponse{}, client.SendSessions("abc", testSessionsPayload()))
+}
+
+func TestNewSessionCount(t *testing.T) {
+	loc := time.FixedZone("CEST", 2*60*60)
+
+	actual := NewSessionCount(time.Date(2022, 7, 12, 12, 5, 42, 10, loc), 3)
+	assert.Equal(t, &SessionCount{StartedAt: "2022-07-12T10:05:00Z", SessionsStarted: 3}, actual)
+}
+
+func TestSessionsPayloadValidate(t *testing.T) {
+	cases := []struct {
+		name   string
+		modify func(*SessionsPayload)
+		err    string
+	}{
+		{name: "it accepts a valid payload", modify: func(*SessionsPayload) {}},
+		{
+			name:   "it requires app version",
+			modify: func(p *SessionsPayload) { p.App.Version = "" },
+			err:    "bugsnag: sessions app version is required",
+		},
+		{
+			name:   "it requires release stage",
+			modify: func(p *SessionsPayload) { p.App.ReleaseStage = "" },
+			err:    "bugsnag: sessions release stage is required",
+		},
+		{
+			name:   "it requires session counts",
+			modify: func(p *SessionsPayload) { p.SessionCounts = nil },
+			err:    "bugsnag: sessions payload needs at least one session count",
+		},
+		{
+			name:   "it requires minute start times",
+			modify: func(p *SessionsPayload) { p.SessionCounts[0].StartedAt = "2022-07-12T10:00:30Z" },
+			err:    `bugsnag: session start time "2022-07-12T10:00:30Z" must be the start of a minute`,
+		},
+		{
+			name:   "it requires valid start times",
+			modify: func(p *SessionsPayload) { p.SessionCounts[0].StartedAt = "yesterday" },
+			err:    `bugsnag: invalid session start time "yesterday", must be in RFC3339 format`,
+		},
+		{
+			name:   "it requires positive counts",
+			modify: func(p *SessionsPayload) { p.SessionCounts[1].SessionsStarted = 0 },
+			err:    "bugsnag: session count at 2022-07-12T10:01:00Z must be positive",
+		},
+		{
+			name:   "it rejects duplicate minutes",
+			modify: func(p *SessionsPayload) { p.SessionCounts[1].StartedAt = "2022-07-12T10:00:00Z" },
+			err:    "bugsnag: duplicate session count at 2022-07-12T10:00:00Z",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := testSessionsPayload()
+			tc.modify(p)
+
+			err := p.Validate()
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
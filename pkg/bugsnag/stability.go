@@ -0,0 +1,68 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// StabilityTrend holds response from /projects/{project_id}/stability_trend endpoint.
+type StabilityTrend struct {
+	ProjectID        string                `json:"project_id"`
+	ReleaseStageName string                `json:"release_stage_name"`
+	TimelinePoints   []*StabilityTimePoint `json:"timeline_points"`
+}
+
+// StabilityTimePoint holds session and user counts of a time bucket.
+type StabilityTimePoint struct {
+	BucketStart            string  `json:"bucket_start"`
+	BucketEnd              string  `json:"bucket_end"`
+	TotalSessionsCount     int     `json:"total_sessions_count"`
+	UnhandledSessionsCount int     `json:"unhandled_sessions_count"`
+	UnhandledRate          float64 `json:"unhandled_rate"`
+	UsersSeen              int     `json:"users_seen"`
+	UsersWithUnhandled     int     `json:"users_with_unhandled"`
+	UnhandledUserRate      float64 `json:"unhandled_user_rate"`
+}
+
+// CrashFreeSessions returns the share of sessions without unhandled errors.
+// It returns false if no sessions were tracked.
+func (p *StabilityTimePoint) CrashFreeSessions() (float64, bool) {
+	return crashFree(p.UnhandledSessionsCount, p.TotalSessionsCount)
+}
+
+// CrashFreeUsers returns the share of users without unhandled errors.
+// It returns false if no users were tracked.
+func (p *StabilityTimePoint) CrashFreeUsers() (float64, bool) {
+	return crashFree(p.UsersWithUnhandled, p.UsersSeen)
+}
+
+// GetStabilityTrend fetches daily stability of a project in the release stage.
+func (c *Client) GetStabilityTrend(projectID, stage string) (*StabilityTrend, error) {
+	params := url.Values{}
+	if stage != "" {
+		params.Set("release_stage_name", stage)
+	}
+
+	path := "/projects/" + url.PathEscape(projectID) + "/stability_trend" + buildQuery(params, nil)
+
+	res, err := c.Get(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out StabilityTrend
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
@@ -0,0 +1,60 @@
+package bugsnag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetStabilityTrend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/60a1b2c3d4e5f6a7b8c9d0e1/stability_trend", r.URL.Path)
+		assert.Equal(t, "production", r.URL.Query().Get("release_stage_name"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{
+			"project_id": "60a1b2c3d4e5f6a7b8c9d0e1",
+			"release_stage_name": "production",
+			"timeline_points": [
+				{
+					"bucket_start": "2022-07-11T00:00:00Z",
+					"bucket_end": "2022-07-12T00:00:00Z",
+					"total_sessions_count": 200,
+					"unhandled_sessions_count": 5,
+					"users_seen": 50,
+					"users_with_unhandled": 0
+				},
+				{
+					"bucket_start": "2022-07-12T00:00:00Z",
+					"bucket_end": "2022-07-13T00:00:00Z",
+					"total_sessions_count": 0,
+					"unhandled_sessions_count": 0,
+					"users_seen": 0,
+					"users_with_unhandled": 0
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	actual, err := client.GetStabilityTrend("60a1b2c3d4e5f6a7b8c9d0e1", "production")
+	assert.NoError(t, err)
+	assert.Equal(t, "production", actual.ReleaseStageName)
+	assert.Len(t, actual.TimelinePoints, 2)
+
+	sessions, ok := actual.TimelinePoints[0].CrashFreeSessions()
+	assert.True(t, ok)
+	assert.InDelta(t, 0.975, sessions, 1e-9)
+
+	users, ok := actual.TimelinePoints[0].CrashFreeUsers()
+	assert.True(t, ok)
+	assert.InDelta(t, 1.0, users, 1e-9)
+
+	_, ok = actual.TimelinePoints[1].CrashFreeUsers()
+	assert.False(t, ok)
+}
@@ -0,0 +1,63 @@
+package bugsnag
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// MaxTrendBuckets is the maximum number of buckets supported by the trend endpoints.
+const MaxTrendBuckets = 50
+
+// TrendBucket holds number of events received in a time range.
+type TrendBucket struct {
+	From        string `json:"from"`
+	To          string `json:"to"`
+	EventsCount int    `json:"events_count"`
+}
+
+// TrendOptions selects how events are bucketed. Only one of
+// BucketsCount and Resolution can be set.
+type TrendOptions struct {
+	BucketsCount int
+	// Resolution is the size of each bucket, eg: 1h or 1d.
+	Resolution string
+}
+
+// GetTrend fetches event counts over time for an error, or for the whole
+// project if errorID is empty, from the /trend endpoints.
+func (c *Client) GetTrend(projectID, errorID string, filters Filters, opts *TrendOptions) ([]*TrendBucket, error) {
+	params := url.Values{}
+	if opts != nil && opts.BucketsCount > 0 {
+		params.Set("buckets_count", strconv.Itoa(opts.BucketsCount))
+	}
+	if opts != nil && opts.Resolution != "" {
+		params.Set("resolution", opts.Resolution)
+	}
+
+	path := "/projects/" + url.PathEscape(projectID)
+	if errorID != "" {
+		path += "/errors/" + url.PathEscape(errorID)
+	}
+
+	res, err := c.Get(context.Background(), path+"/trend"+buildQuery(params, filters), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*TrendBucket
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
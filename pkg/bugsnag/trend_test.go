@@ -0,0 +1,44 @@
+package bugsnag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTrend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/projects/p1/trend":
+			assert.Equal(t, "1h", r.URL.Query().Get("resolution"))
+			assert.Empty(t, r.URL.Query().Get("buckets_count"))
+			assert.Equal(t, "production", r.URL.Query().Get("filters[app.release_stage][][value]"))
+		case "/projects/p1/errors/e1/trend":
+			assert.Equal(t, "12", r.URL.Query().Get("buckets_count"))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[
+			{"from": "2022-07-01T10:00:00.000Z", "to": "2022-07-01T11:00:00.000Z", "events_count": 3},
+			{"from": "2022-07-01T11:00:00.000Z", "to": "2022-07-01T12:00:00.000Z", "events_count": 42}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{APIEndpoint: server.URL, APIToken: "secret"})
+
+	filters := Filters{}
+	filters.Add("app.release_stage", FilterTypeEq, "production")
+
+	actual, err := client.GetTrend("p1", "", filters, &TrendOptions{Resolution: "1h"})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, 42, actual[1].EventsCount)
+
+	_, err = client.GetTrend("p1", "e1", nil, &TrendOptions{BucketsCount: 12})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,104 @@
+package upload
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Find expands paths to a sorted list of files. Paths may be glob patterns,
+// see filepath.Match, and directories are walked for files whose name matches
+// pattern. Files given explicitly are returned whether they match or not.
+func Find(paths []string, pattern string) ([]string, error) {
+	files := make(map[string]struct{})
+
+	err := expand(paths, files, func(path string, d fs.DirEntry) error {
+		if ok, _ := filepath.Match(pattern, d.Name()); ok && !d.IsDir() {
+			files[path] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedPaths(files), nil
+}
+
+// FindDSYMs expands paths to DWARF files of dSYM bundles. Paths may be glob
+// patterns, dSYM bundles or directories containing them. Zip archives and
+// files inside bundles are returned as they are.
+func FindDSYMs(paths []string) ([]string, error) {
+	files := make(map[string]struct{})
+
+	err := expand(paths, files, func(path string, d fs.DirEntry) error {
+		if !d.IsDir() || !strings.EqualFold(filepath.Ext(path), ".dsym") {
+			return nil
+		}
+
+		dwarf, err := filepath.Glob(filepath.Join(path, "Contents", "Resources", "DWARF", "*"))
+		if err != nil {
+			return err
+		}
+		if len(dwarf) == 0 {
+			return fmt.Errorf("no DWARF files found in %s", path)
+		}
+		for _, f := range dwarf {
+			files[f] = struct{}{}
+		}
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedPaths(files), nil
+}
+
+// expand expands glob patterns in paths, adding matching files to files
+// and walking matching directories with visit.
+func expand(paths []string, files map[string]struct{}, visit func(path string, d fs.DirEntry) error) error {
+	for _, p := range paths {
+		matches, err := filepath.Glob(p)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+		if len(matches) == 0 {
+			return fmt.Errorf("no files match %q", p)
+		}
+
+		for _, m := range matches {
+			info, err := os.Stat(m)
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				files[filepath.Clean(m)] = struct{}{}
+				continue
+			}
+
+			err = filepath.WalkDir(m, func(path string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				return visit(path, d)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func sortedPaths(set map[string]struct{}) []string {
+	out := make([]string, 0, len(set))
+	for p := range set {
+		out = append(out, p)
+	}
+	sort.Strings(out)
+	return out
+}
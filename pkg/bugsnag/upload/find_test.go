@@ -0,0 +1,48 @@
+package upload
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	dir := t.TempDir()
+	createFile(t, dir, "dist/app.js", "")
+	createFile(t, dir, "dist/app.js.map", "")
+	createFile(t, dir, "dist/js/vendor.js.map", "")
+	createFile(t, dir, "other/extra.js.map", "")
+	createFile(t, dir, "other/notes.txt", "")
+
+	actual, err := Find([]string{filepath.Join(dir, "dist"), filepath.Join(dir, "other", "*.txt")}, "*.map")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "dist/app.js.map"),
+		filepath.Join(dir, "dist/js/vendor.js.map"),
+		filepath.Join(dir, "other/notes.txt"),
+	}, actual)
+
+	_, err = Find([]string{filepath.Join(dir, "missing")}, "*.map")
+	assert.Error(t, err)
+}
+
+func TestFindDSYMs(t *testing.T) {
+	dir := t.TempDir()
+	createFile(t, dir, "build/App.app.dSYM/Contents/Info.plist", "")
+	createFile(t, dir, "build/App.app.dSYM/Contents/Resources/DWARF/App", "")
+	createFile(t, dir, "build/Ext.appex.dSYM/Contents/Resources/DWARF/Ext", "")
+	createFile(t, dir, "Symbols.zip", "")
+
+	actual, err := FindDSYMs([]string{filepath.Join(dir, "build"), filepath.Join(dir, "*.zip")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "Symbols.zip"),
+		filepath.Join(dir, "build/App.app.dSYM/Contents/Resources/DWARF/App"),
+		filepath.Join(dir, "build/Ext.appex.dSYM/Contents/Resources/DWARF/Ext"),
+	}, actual)
+
+	createFile(t, dir, "empty/Broken.dSYM/Contents/Info.plist", "")
+	_, err = FindDSYMs([]string{filepath.Join(dir, "empty")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,110 @@
+package upload
+
+import (
+	"fmt"
+)
+
+// SourceMap is an upload of a javascript source map.
+type SourceMap struct {
+	APIKey     string
+	AppVersion string
+	// MinifiedURL is the url the minified file is served from, it may contain wildcards, eg: */js/app.js.
+	MinifiedURL string
+	// SourceMap is the path to the source map.
+	SourceMap string
+	// MinifiedFile is the path to the minified file, optional.
+	MinifiedFile string
+	Overwrite    bool
+}
+
+// Form implements Request interface.
+func (s *SourceMap) Form() (*Form, error) {
+	if s.APIKey == "" {
+		return nil, fmt.Errorf("source map upload needs an api key")
+	}
+	if s.MinifiedURL == "" || s.SourceMap == "" {
+		return nil, fmt.Errorf("source map upload needs a source map and the url of the minified file")
+	}
+
+	f := Form{
+		Path: "/sourcemap",
+		Fields: map[string]string{
+			"apiKey":      s.APIKey,
+			"appVersion":  s.AppVersion,
+			"minifiedUrl": s.MinifiedURL,
+			"overwrite":   overwrite(s.Overwrite),
+		},
+		Files: map[string]string{"sourceMap": s.SourceMap},
+	}
+	if s.MinifiedFile != "" {
+		f.Files["minifiedFile"] = s.MinifiedFile
+	}
+	return &f, nil
+}
+
+// ProGuard is an upload of an android proguard or r8 mapping file.
+type ProGuard struct {
+	APIKey string
+	// AppID is the application id of the android app, eg: com.example.app.
+	AppID       string
+	VersionName string
+	VersionCode string
+	// BuildUUID identifies the build when version name and code are not unique, optional.
+	BuildUUID string
+	// File is the path to the mapping file.
+	File      string
+	Overwrite bool
+}
+
+// Form implements Request interface.
+func (p *ProGuard) Form() (*Form, error) {
+	if p.APIKey == "" {
+		return nil, fmt.Errorf("proguard upload needs an api key")
+	}
+	if p.AppID == "" || p.File == "" {
+		return nil, fmt.Errorf("proguard upload needs a mapping file and an app id")
+	}
+	if p.BuildUUID == "" && (p.VersionName == "" || p.VersionCode == "") {
+		return nil, fmt.Errorf("proguard upload needs either a build uuid or both version name and version code")
+	}
+
+	return &Form{
+		Path: "/proguard",
+		Fields: map[string]string{
+			"apiKey":      p.APIKey,
+			"appId":       p.AppID,
+			"versionName": p.VersionName,
+			"versionCode": p.VersionCode,
+			"buildUUID":   p.BuildUUID,
+			"overwrite":   overwrite(p.Overwrite),
+		},
+		Files: map[string]string{"proguard": p.File},
+	}, nil
+}
+
+// DSYM is an upload of an ios or macos debug symbol file.
+type DSYM struct {
+	APIKey string
+	// File is the path to a DWARF file inside a dSYM bundle or a zipped dSYM bundle.
+	File      string
+	Overwrite bool
+}
+
+// Form implements Request interface.
+func (d *DSYM) Form() (*Form, error) {
+	if d.APIKey == "" {
+		return nil, fmt.Errorf("dsym upload needs an api key")
+	}
+	if d.File == "" {
+		return nil, fmt.Errorf("dsym upload needs a file")
+	}
+
+	return &Form{
+		Path: "/dsym",
+		Fields: map[string]string{
+			"apiKey":    d.APIKey,
+			"overwrite": overwrite(d.Overwrite),
+		},
+		Files: map[string]string{"dsym": d.File},
+	}, nil
+}
@@ -0,0 +1,316 @@
+// Package upload uploads source maps and symbol files to the bugsnag upload api
+// so that stacktraces of minified or compiled apps can be made readable.
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+// DefaultEndpoint is the endpoint of the bugsnag upload api.
+const DefaultEndpoint = "https://upload.bugsnag.com"
+
+// maxErrorBody limits how much of an error response is kept.
+const maxErrorBody = 4096
+
+// Request is a single upload to the upload api.
+type Request interface {
+	// Form returns the multipart form of the upload, or an error if the upload is invalid.
+	Form() (*Form, error)
+}
+
+// Form is a multipart form posted to a path of the upload api.
+type Form struct {
+	Path   string
+	Fields map[string]string
+	// Files maps form field names to paths of the uploaded files.
+	Files map[string]string
+}
+
+// ResponseError is returned if the upload api rejects an upload.
+type ResponseError struct {
+	StatusCode int
+	Status     string
+	Message    string
+}
+
+func (e *ResponseError) Error() string {
+	if e.Message == "" {
+		return "upload failed: " + e.Status
+	}
+	return fmt.Sprintf("upload failed: %s: %s", e.Status, e.Message)
+}
+
+// Config is an upload client config.
+type Config struct {
+	// Endpoint defaults to DefaultEndpoint.
+	Endpoint string
+	// Timeout of a single upload, no timeout if zero.
+	Timeout time.Duration
+	// Transport sends the uploads, defaults to http.DefaultTransport. Pass the
+	// transport of a bugsnag.Client to share its proxy and tls settings.
+	Transport http.RoundTripper
+	// RetryPolicy retries rate limited uploads, uploads aren't retried if zero.
+	RetryPolicy bugsnag.RetryPolicy
+	// Debug dumps request and response headers of each upload to stdout.
+	Debug bool
+}
+
+// Client uploads files to the upload api.
+type Client struct {
+	endpoint    string
+	http        *http.Client
+	retryPolicy bugsnag.RetryPolicy
+	debug       bool
+}
+
+// NewClient instantiates new upload client.
+func NewClient(c Config) *Client {
+	endpoint := strings.TrimSuffix(c.Endpoint, "/")
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+
+	return &Client{
+		endpoint:    endpoint,
+		http:        &http.Client{Timeout: c.Timeout, Transport: c.Transport},
+		retryPolicy: c.RetryPolicy,
+		debug:       c.Debug,
+	}
+}
+
+// Upload sends a single upload request. Files are streamed from disk, and
+// streamed again if the upload is retried.
+func (c *Client) Upload(ctx context.Context, r Request) error {
+	form, err := r.Form()
+	if err != nil {
+		return err
+	}
+
+	// Files are streamed, the size of the body is computed upfront as
+	// not every server accepts chunked uploads.
+	size, boundary, err := formSize(form)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		res, err := c.post(ctx, form, size, boundary)
+
+		wait, retry := c.retryPolicy.ShouldRetry(http.MethodPost, attempt, res, err)
+		if !retry {
+			if err != nil {
+				return err
+			}
+			return readResponse(res)
+		}
+
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Client) post(ctx context.Context, form *Form, size int64, boundary string) (*http.Response, error) {
+	body, w := io.Pipe()
+	mw := multipart.NewWriter(w)
+	if err := mw.SetBoundary(boundary); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		_ = w.CloseWithError(writeForm(mw, form, true))
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+form.Path, body)
+	if err != nil {
+		_ = body.Close()
+		return nil, err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		_ = body.Close()
+	}
+	if c.debug {
+		dump(req, res)
+	}
+	return res, err
+}
+
+// readResponse closes the response, returning ResponseError if the upload was rejected.
+func readResponse(res *http.Response) error {
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBody))
+		return &ResponseError{
+			StatusCode: res.StatusCode,
+			Status:     res.Status,
+			Message:    strings.TrimSpace(string(msg)),
+		}
+	}
+
+	_, err := io.Copy(io.Discard, res.Body)
+	return err
+}
+
+// UploadAll sends upload requests with at most concurrency uploads in flight.
+// It returns one error per request, nil for successful uploads. The optional
+// done callback is called as each upload finishes, from multiple goroutines.
+func (c *Client) UploadAll(ctx context.Context, requests []Request, concurrency int, done func(i int, err error)) []error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		errs = make([]error, len(requests))
+		sem  = make(chan struct{}, concurrency)
+		wg   sync.WaitGroup
+	)
+	for i, r := range requests {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			for j := i; j < len(requests); j++ {
+				errs[j] = ctx.Err()
+			}
+			wg.Wait()
+			return errs
+		}
+
+		wg.Add(1)
+		go func(i int, r Request) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = c.Upload(ctx, r)
+			if done != nil {
+				done(i, errs[i])
+			}
+		}(i, r)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// dump writes headers of the request and response to stdout. Bodies are
+// left out as they are streamed and may be large.
+func dump(req *http.Request, res *http.Response) {
+	const separatorWidth = 60
+
+	reqDump, _ := httputil.DumpRequestOut(req, false)
+	fmt.Printf("\n\nUPLOAD REQUEST DETAILS\n%s\n\n%s", strings.Repeat("-", separatorWidth), reqDump)
+
+	if res != nil {
+		resDump, _ := httputil.DumpResponse(res, false)
+		fmt.Printf("\n\nUPLOAD RESPONSE DETAILS\n%s\n\n%s", strings.Repeat("-", separatorWidth), resDump)
+	}
+}
+
+// formSize returns the size of the encoded form and the boundary it was encoded with.
+func formSize(form *Form) (int64, string, error) {
+	var (
+		c    counter
+		size int64
+	)
+
+	mw := multipart.NewWriter(&c)
+	for _, path := range form.Files {
+		info, err := os.Stat(path)
+		if err != nil {
+			return 0, "", err
+		}
+		size += info.Size()
+	}
+	if err := writeForm(mw, form, false); err != nil {
+		return 0, "", err
+	}
+
+	return size + c.n, mw.Boundary(), nil
+}
+
+// writeForm writes fields and files of the form to mw. Only the part
+// headers of files are written unless withFiles is set.
+func writeForm(mw *multipart.Writer, form *Form, withFiles bool) error {
+	for k, v := range form.Fields {
+		if v == "" {
+			continue
+		}
+		if err := mw.WriteField(k, v); err != nil {
+			return err
+		}
+	}
+	for field, path := range form.Files {
+		part, err := mw.CreateFormFile(field, filepath.Base(path))
+		if err != nil {
+			return err
+		}
+		if withFiles {
+			if err := copyFile(part, path); err != nil {
+				return err
+			}
+		}
+	}
+	return mw.Close()
+}
+
+func copyFile(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// counter is a writer counting bytes written to it.
+type counter struct {
+	n int64
+}
+
+func (c *counter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
+
+func overwrite(b bool) string {
+	if b {
+		return "true"
+	}
+	return ""
+}
@@ -0,0 +1,201 @@
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/teamupstart/bugsnag-data-cli/pkg/bugsnag"
+)
+
+func createFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	return path
+}
+
+func TestUploadSourceMap(t *testing.T) {
+	dir := t.TempDir()
+	sourceMap := createFile(t, dir, "app.js.map", `{"version":3}`)
+	minified := createFile(t, dir, "app.js", "console.log(1)")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/sourcemap", r.URL.Path)
+		assert.Empty(t, r.TransferEncoding)
+		assert.Greater(t, r.ContentLength, int64(0))
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+
+		assert.Equal(t, "abc", r.FormValue("apiKey"))
+		assert.Equal(t, "2.4.1", r.FormValue("appVersion"))
+		assert.Equal(t, "https://example.com/js/app.js", r.FormValue("minifiedUrl"))
+		assert.Equal(t, "true", r.FormValue("overwrite"))
+
+		cases := []struct{ field, name, content string }{
+			{field: "sourceMap", name: "app.js.map", content: `{"version":3}`},
+			{field: "minifiedFile", name: "app.js", content: "console.log(1)"},
+		}
+		for _, c := range cases {
+			f, h, err := r.FormFile(c.field)
+			assert.NoError(t, err)
+			b, _ := io.ReadAll(f)
+			assert.Equal(t, c.content, string(b))
+			assert.Equal(t, c.name, h.Filename)
+		}
+
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Endpoint: server.URL})
+
+	err := client.Upload(context.Background(), &SourceMap{
+		APIKey:       "abc",
+		AppVersion:   "2.4.1",
+		MinifiedURL:  "https://example.com/js/app.js",
+		SourceMap:    sourceMap,
+		MinifiedFile: minified,
+		Overwrite:    true,
+	})
+	assert.NoError(t, err)
+}
+
+func TestUploadErrors(t *testing.T) {
+	dir := t.TempDir()
+	mapping := createFile(t, dir, "mapping.txt", "a -> b")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/proguard", r.URL.Path)
+
+		w.WriteHeader(409)
+		_, _ = w.Write([]byte("Duplicate upload, use overwrite\n"))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Endpoint: server.URL + "/"})
+
+	err := client.Upload(context.Background(), &ProGuard{
+		APIKey: "abc", AppID: "com.example.app", VersionName: "2.4.1", VersionCode: "42", File: mapping,
+	})
+	assert.EqualError(t, err, "upload failed: 409 Conflict: Duplicate upload, use overwrite")
+	assert.IsType(t, &ResponseError{}, err)
+
+	err = client.Upload(context.Background(), &ProGuard{APIKey: "abc", AppID: "com.example.app", File: mapping})
+	assert.Error(t, err)
+
+	err = client.Upload(context.Background(), &DSYM{APIKey: "abc", File: filepath.Join(dir, "missing")})
+	assert.True(t, os.IsNotExist(err))
+}
+
+type countingTransport struct {
+	requests int32
+}
+
+func (t *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&t.requests, 1)
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestUploadRetries(t *testing.T) {
+	dir := t.TempDir()
+	mapping := createFile(t, dir, "mapping.txt", "a -> b")
+
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The form is sent in full on every attempt.
+		f, _, err := r.FormFile("proguard")
+		assert.NoError(t, err)
+		b, _ := io.ReadAll(f)
+		assert.Equal(t, "a -> b", string(b))
+
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	transport := &countingTransport{}
+
+	client := NewClient(Config{
+		Endpoint:    server.URL,
+		Transport:   transport,
+		RetryPolicy: bugsnag.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond},
+	})
+
+	err := client.Upload(context.Background(), &ProGuard{APIKey: "abc", AppID: "com.example.app", BuildUUID: "b1", File: mapping})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, attempts)
+	assert.EqualValues(t, 2, transport.requests)
+
+	// Uploads are not retried without a retry policy.
+	attempts = 0
+
+	client = NewClient(Config{Endpoint: server.URL})
+
+	err = client.Upload(context.Background(), &ProGuard{APIKey: "abc", AppID: "com.example.app", BuildUUID: "b1", File: mapping})
+	assert.EqualError(t, err, "upload failed: 429 Too Many Requests")
+}
+
+func TestUploadAll(t *testing.T) {
+	dir := t.TempDir()
+
+	var inFlight, maxInFlight int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		f, h, err := r.FormFile("dsym")
+		assert.NoError(t, err)
+		_ = f.Close()
+		if h.Filename == "fail" {
+			w.WriteHeader(400)
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	var requests []Request
+	for i := 0; i < 8; i++ {
+		requests = append(requests, &DSYM{APIKey: "abc", File: createFile(t, dir, fmt.Sprintf("App%d", i), "dwarf")})
+	}
+	requests = append(requests, &DSYM{APIKey: "abc", File: createFile(t, dir, "fail", "dwarf")})
+
+	var done int32
+
+	client := NewClient(Config{Endpoint: server.URL})
+	errs := client.UploadAll(context.Background(), requests, 3, func(int, error) { atomic.AddInt32(&done, 1) })
+
+	assert.Len(t, errs, 9)
+	for _, err := range errs[:8] {
+		assert.NoError(t, err)
+	}
+	assert.Error(t, errs[8])
+	assert.Equal(t, int32(9), done)
+	assert.LessOrEqual(t, maxInFlight, int32(3))
+}